@@ -25,6 +25,46 @@ var doc = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/resource/apply": {
+            "post": {
+                "description": "解析包含多个对象的资源清单，不存在的对象会被创建，已存在的对象会被替换，返回每个对象的处理结果.\n清单可以放在 JSON 请求体的 content 字段中，也可以通过 multipart/form-data 的 file 字段上传.\n普通用户只能将对象应用到自己拥有的命名空间，且不能创建集群级别的对象.",
+                "consumes": [
+                    "application/json",
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "根据 YAML 或 JSON 资源清单创建或更新对象",
+                "parameters": [
+                    {
+                        "description": "资源清单和应用参数",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/apply.ApplyRequest"
+                        }
+                    },
+                    {
+                        "type": "file",
+                        "description": "资源清单文件",
+                        "name": "file",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":[{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"name\":\"\",\"namespace\":\"\",\"action\":\"created\"}]}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/resource/configmap/create": {
             "post": {
                 "description": "创建 ConfigMap 对象",
@@ -146,6 +186,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -158,13 +222,16 @@ var doc = `{
                 }
             }
         },
-        "/resource/container/logs/{namespace}/{podId}/{containerId}": {
+        "/resource/container/download/{namespace}/{podId}/{container}": {
             "get": {
-                "description": "获取某一 Container 对象的 Logs.",
+                "description": "以附件的形式下载某一 Container 对象的完整日志，日志直接从 apiserver 流式转发，不会整体加载到内存中.",
+                "produces": [
+                    "text/plain"
+                ],
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取某一 Container 对象的 Logs.",
+                "summary": "下载某一 Container 对象的完整日志.",
                 "parameters": [
                     {
                         "type": "string",
@@ -183,16 +250,141 @@ var doc = `{
                     {
                         "type": "string",
                         "description": "Container",
-                        "name": "containerId",
+                        "name": "container",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否下载上一次运行的容器的日志",
+                        "name": "previous",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否使用 gzip 压缩",
+                        "name": "compress",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "description": "日志文件",
                         "schema": {
-                            "$ref": "#/definitions/tool.Response"
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/container/logs/{namespace}/{podId}/{container}": {
+            "get": {
+                "description": "将 get 请求升级为 WebSocket 协议，持续推送 Container 对象新产生的日志，每条消息为一行日志.",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "实时获取某一 Container 对象的 Logs.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PodID",
+                        "name": "podId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container",
+                        "name": "container",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "从最后多少行日志开始推送，未指定 tailLines 和 sinceSeconds 时为 100",
+                        "name": "tailLines",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "从多少秒之前的日志开始推送",
+                        "name": "sinceSeconds",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否返回日志的时间戳",
+                        "name": "timestamps",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "是否获取上一次运行的容器的日志",
+                        "name": "previous",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"timestamp\":\"\",\"content\":\"\"}",
+                        "schema": {
+                            "$ref": "#/definitions/logs.LogLine"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/container/shell/{namespace}/{podId}/{container}": {
+            "get": {
+                "description": "创建在 Container 中执行 shell 的终端会话，返回的会话 ID 需要在 30 秒内通过 /v1/terminal/{id} 连接，否则会话失效.\n未指定 shell 或 shell 无效时，依次尝试 bash 和 sh.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建某一 Container 对象的终端会话.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PodID",
+                        "name": "podId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Container",
+                        "name": "container",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "使用的 shell，可选 bash、sh",
+                        "name": "shell",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"id\":\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/terminal.ShellResponse"
                         }
                     }
                 }
@@ -319,6 +511,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -331,9 +547,43 @@ var doc = `{
                 }
             }
         },
-        "/resource/deployment/delete": {
+        "/resource/daemonset/create": {
+            "post": {
+                "description": "创建DaemonSet对象，在所有节点或者匹配 nodeSelector 的节点上各运行一个 Pod，通过 tolerations 可以调度到有污点的节点上. 命名空间必须已经存在.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建DaemonSet对象",
+                "parameters": [
+                    {
+                        "description": "创建DaemonSet对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/daemonset.CreateDaemonSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/daemonset/delete": {
             "delete": {
-                "description": "删除指定Deployment对象.",
+                "description": "删除指定DaemonSet对象及其在各个节点上的Pod.",
                 "consumes": [
                     "application/json"
                 ],
@@ -343,15 +593,15 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "删除指定Deployment对象.",
+                "summary": "删除指定DaemonSet对象.",
                 "parameters": [
                     {
-                        "description": "删除一个Deployment对象时所需参数.",
+                        "description": "删除一个DaemonSet对象时所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/deployment.DeleteDeploymentRequest"
+                            "$ref": "#/definitions/daemonset.DeleteDaemonSetRequest"
                         }
                     }
                 ],
@@ -365,9 +615,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/deployment/detail/{name}/{namespace}": {
+        "/resource/daemonset/detail/{name}/{namespace}": {
             "get": {
-                "description": "查询某一 Deployment 对象的详情",
+                "description": "查询某一 DaemonSet 对象的详情，包括节点选择器、容忍、更新策略和各节点上 Pod 的调度状态",
                 "consumes": [
                     "application/json"
                 ],
@@ -377,11 +627,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Deployment 对象的详情",
+                "summary": "查询某一 DaemonSet 对象的详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Deployment 对象名称",
+                        "description": "DaemonSet 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -404,13 +654,70 @@ var doc = `{
                 }
             }
         },
-        "/resource/deployment/list/{namespace}": {
+        "/resource/daemonset/events/{name}/{namespace}": {
             "get": {
-                "description": "获取某一用户创建的所有 Deployment 对象",
+                "description": "查询某一 DaemonSet 对象及其管理的 Pod 的事件，如节点资源不足导致 Pod 无法调度等",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取某一用户创建的所有 Deployment 对象",
+                "summary": "查询某一 DaemonSet 对象的事件列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "DaemonSet 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,lastSeen",
+                        "name": "sortBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/daemonset/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有 DaemonSet 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有 DaemonSet 对象",
                 "parameters": [
                     {
                         "type": "string",
@@ -418,6 +725,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -430,9 +761,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/deployment/pods/{name}/{namespace}": {
+        "/resource/daemonset/pods/{name}/{namespace}": {
             "get": {
-                "description": "查询某一 Deployment 对象控制的Pods列表",
+                "description": "查询某一 DaemonSet 对象控制的Pods列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -442,11 +773,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Deployment 对象控制的Pods列表",
+                "summary": "查询某一 DaemonSet 对象控制的Pods列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Deployment 对象名称",
+                        "description": "DaemonSet 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -457,6 +788,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -469,9 +824,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/job/create": {
-            "post": {
-                "description": "创建Job对象",
+        "/resource/daemonset/update": {
+            "put": {
+                "description": "更新DaemonSet对象中指定容器的镜像，或者修改更新策略. RollingUpdate 策略下按 maxUnavailable 逐个节点滚动更新，OnDelete 策略下只有手动删除的 Pod 才会被更新.\ncontainer 为空时不修改镜像，strategyType 为空时保持原有的更新策略.",
                 "consumes": [
                     "application/json"
                 ],
@@ -481,21 +836,21 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "创建Job对象",
+                "summary": "更新DaemonSet对象的镜像和更新策略.",
                 "parameters": [
                     {
-                        "description": "创建Job对象所需参数.",
+                        "description": "更新DaemonSet对象时所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/job.CreateJobRequest"
+                            "$ref": "#/definitions/daemonset.UpdateDaemonSetRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -503,9 +858,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/job/delete": {
-            "delete": {
-                "description": "删除指定Job对象",
+        "/resource/deployment/create": {
+            "post": {
+                "description": "创建Deployment对象，指定端口映射时同时创建同名的Service对象. 命名空间必须已经存在.",
                 "consumes": [
                     "application/json"
                 ],
@@ -515,21 +870,21 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "删除指定Job对象",
+                "summary": "创建Deployment对象",
                 "parameters": [
                     {
-                        "description": "删除参数",
+                        "description": "创建Deployment对象所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/job.DeleteJobRequest"
+                            "$ref": "#/definitions/deployment.CreateDeploymentRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -537,9 +892,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/job/detail/{name}/{namespace}": {
-            "get": {
-                "description": "查询某一Job对象的详情",
+        "/resource/deployment/delete": {
+            "delete": {
+                "description": "删除指定Deployment对象.",
                 "consumes": [
                     "application/json"
                 ],
@@ -549,48 +904,17 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一Job对象的详情",
+                "summary": "删除指定Deployment对象.",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Job对象名称",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "用户的命名空间",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "description": "删除一个Deployment对象时所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/tool.Response"
+                            "$ref": "#/definitions/deployment.DeleteDeploymentRequest"
                         }
                     }
-                }
-            }
-        },
-        "/resource/job/list/{namespace}": {
-            "get": {
-                "description": "获取某一用户创建的所有Job对象",
-                "tags": [
-                    "resource"
-                ],
-                "summary": "获取某一用户创建的所有Job对象",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "用户的命名空间",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    }
                 ],
                 "responses": {
                     "200": {
@@ -602,9 +926,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/job/pods/{name}/{namespace}": {
+        "/resource/deployment/detail/{name}/{namespace}": {
             "get": {
-                "description": "查询某一Job对象控制的Pods列表",
+                "description": "查询某一 Deployment 对象的详情",
                 "consumes": [
                     "application/json"
                 ],
@@ -614,11 +938,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一Job对象控制的Pods列表",
+                "summary": "查询某一 Deployment 对象的详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Job对象名称",
+                        "description": "Deployment 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -641,9 +965,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/persistentvolumeclaim/create": {
-            "post": {
-                "description": "创建PersistentVolumeClaim对象",
+        "/resource/deployment/image": {
+            "put": {
+                "description": "更新Deployment对象中指定容器的镜像并触发滚动更新.",
                 "consumes": [
                     "application/json"
                 ],
@@ -653,21 +977,21 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "创建PersistentVolumeClaim对象",
+                "summary": "更新Deployment对象的容器镜像.",
                 "parameters": [
                     {
-                        "description": "创建PersistentVolumeClaim对象所需参数.",
+                        "description": "更新容器镜像时所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/persistentvolumeclaim.CreatePersistentVolumeClaimRequest"
+                            "$ref": "#/definitions/deployment.UpdateImageRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -675,28 +999,44 @@ var doc = `{
                 }
             }
         },
-        "/resource/persistentvolumeclaim/delete": {
-            "delete": {
-                "description": "删除指定的PersistentVolumeClaim对象",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/resource/deployment/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有 Deployment 对象",
                 "tags": [
                     "resource"
                 ],
-                "summary": "删除指定的PersistentVolumeClaim对象",
+                "summary": "获取某一用户创建的所有 Deployment 对象",
                 "parameters": [
                     {
-                        "description": "删除参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/persistentvolumeclaim.DeletePersistentVolumeClaimRequest"
-                        }
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -709,9 +1049,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/persistentvolumeclaim/detail/{name}/{namespace}": {
+        "/resource/deployment/pods/{name}/{namespace}": {
             "get": {
-                "description": "查询某一PersistentVolumeClaim对象的详情",
+                "description": "查询某一 Deployment 对象控制的Pods列表",
                 "consumes": [
                     "application/json"
                 ],
@@ -721,11 +1061,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一PersistentVolumeClaim对象的详情",
+                "summary": "查询某一 Deployment 对象控制的Pods列表",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "PersistentVolumeClaim对象名称",
+                        "description": "Deployment 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -736,6 +1076,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -748,44 +1112,24 @@ var doc = `{
                 }
             }
         },
-        "/resource/persistentvolumeclaim/list/{namespace}": {
+        "/resource/deployment/rollout/history/{name}/{namespace}": {
             "get": {
-                "description": "获取某一用户创建的所有PersistentVolumeClaim对象",
-                "tags": [
-                    "resource"
+                "description": "查询Deployment对象的历史版本，按版本号从新到旧排序.",
+                "consumes": [
+                    "application/json"
                 ],
-                "summary": "获取某一用户创建的所有PersistentVolumeClaim对象",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "用户的命名空间",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    }
+                "produces": [
+                    "application/json"
                 ],
-                "responses": {
-                    "200": {
-                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
-                        "schema": {
-                            "$ref": "#/definitions/tool.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/resource/pod/container/{podId}/{namespace}": {
-            "get": {
-                "description": "获取某一 Pod 中的所有容器对象.",
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取某一 Pod 中的所有容器对象.",
+                "summary": "查询Deployment对象的历史版本.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pod ID",
-                        "name": "podId",
+                        "description": "Deployment 对象名称",
+                        "name": "name",
                         "in": "path",
                         "required": true
                     },
@@ -807,9 +1151,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/pod/detail/{name}/{namespace}": {
+        "/resource/deployment/rollout/status/{name}/{namespace}": {
             "get": {
-                "description": "查询某一 Pod 对象的详情",
+                "description": "查询Deployment对象的滚动更新进度，包括新旧ReplicaSet和副本数.",
                 "consumes": [
                     "application/json"
                 ],
@@ -819,11 +1163,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Pod 对象的详情",
+                "summary": "查询Deployment对象的滚动更新状态.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Pod 对象名称",
+                        "description": "Deployment 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -836,32 +1180,6 @@ var doc = `{
                         "required": true
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
-                        "schema": {
-                            "$ref": "#/definitions/tool.Response"
-                        }
-                    }
-                }
-            }
-        },
-        "/resource/pod/list/{namespace}": {
-            "get": {
-                "description": "获取某一命名空间下的所有 Pod 对象",
-                "tags": [
-                    "resource"
-                ],
-                "summary": "获取某一命名空间下的所有 Pod 对象",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "命名空间",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
                 "responses": {
                     "200": {
                         "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
@@ -872,9 +1190,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/secret/create": {
-            "post": {
-                "description": "创建 Secret 对象",
+        "/resource/deployment/rollout/undo": {
+            "put": {
+                "description": "将Deployment对象回滚到指定的历史版本.",
                 "consumes": [
                     "application/json"
                 ],
@@ -884,21 +1202,21 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "创建 Secret 对象",
+                "summary": "回滚Deployment对象.",
                 "parameters": [
                     {
-                        "description": "创建 Secret 对象时所需参数",
+                        "description": "回滚Deployment对象时所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/secret.CreateSecretRequest"
+                            "$ref": "#/definitions/deployment.RollbackRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -906,33 +1224,55 @@ var doc = `{
                 }
             }
         },
-        "/resource/secret/delete": {
-            "delete": {
-                "description": "删除指定Secret对象",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/resource/event/list/{namespace}": {
+            "get": {
+                "description": "获取某一命名空间下的事件，可按事件类型过滤",
                 "tags": [
                     "resource"
                 ],
-                "summary": "删除指定Secret对象",
+                "summary": "获取某一命名空间下的事件",
                 "parameters": [
                     {
-                        "description": "删除参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/secret.DeleteSecretRequest"
-                        }
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件类型，可选值为 Normal 和 Warning",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -940,38 +1280,63 @@ var doc = `{
                 }
             }
         },
-        "/resource/secret/detail/{name}/{namespace}": {
+        "/resource/event/object/{kind}/{name}/{namespace}": {
             "get": {
-                "description": "查询某一 Secret 对象的详情",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "获取某一对象的事件，可按事件类型过滤. 例如 Deployment 无法创建 Pod 时，其 ReplicaSet 和 Pod 的 Warning 事件说明了原因",
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Secret 对象的详情",
+                "summary": "获取某一对象的事件",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Secret 对象名称",
+                        "description": "资源类型，可选值为 pod、deployment、replicaset、statefulset、daemonset、job、cronjob、service、ingress、persistentvolumeclaim 和 horizontalpodautoscaler",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "用户的命名空间",
+                        "description": "命名空间",
                         "name": "namespace",
                         "in": "path",
                         "required": true
-                    }
-                ],
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件类型，可选值为 Normal 和 Warning",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -979,20 +1344,164 @@ var doc = `{
                 }
             }
         },
-        "/resource/secret/list/{namespace}": {
+        "/resource/event/watch/{namespace}": {
             "get": {
-                "description": "获取某一命名空间下的所有 Secret 对象",
+                "description": "将 get 请求升级为 WebSocket 协议，持续推送命名空间中新产生或再次发生的事件，可按对象和事件类型过滤.",
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取某一命名空间下的所有 Secret 对象",
+                "summary": "实时获取某一命名空间下的事件.",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "用户的命名空间",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "资源类型，可选值与 /resource/event/object 相同",
+                        "name": "kind",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "事件类型，可选值为 Normal 和 Warning",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"type\":\"ADDED\",\"event\":{}}",
+                        "schema": {
+                            "$ref": "#/definitions/event.EventMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/export/namespace/{namespace}": {
+            "get": {
+                "description": "导出命名空间中 ConfigMap、Secret、Service、PersistentVolumeClaim、Deployment、StatefulSet、DaemonSet、Job、CronJob、Ingress、HorizontalPodAutoscaler 等对象，\n去掉由服务端填充的字段. 由控制器管理的对象（如 Deployment 创建的 Pod）和集群自动创建的对象不会被导出.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/yaml",
+                    "application/gzip"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "导出命名空间中所有对象的资源清单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "导出格式，可选值为 yaml 和 tar，tar 为每个对象一个文件的 tar.gz 压缩包，默认为 yaml",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "资源清单文件",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/export/object/{kind}/{namespace}/{name}": {
+            "get": {
+                "description": "导出对象的定义，去掉 status、managedFields、resourceVersion、uid 等由服务端填充的字段，导出的清单可以直接用于 /resource/apply.\n资源类型可以是单数或复数形式，也可以带上 API 组，如 deployment、deployments.apps. 集群级别的对象使用 _cluster 作为命名空间，只有管理员可以导出.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/yaml",
+                    "application/gzip"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "导出单个对象的资源清单",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间，集群级别的对象为 _cluster",
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "导出格式，可选值为 yaml 和 tar，tar 为 tar.gz 压缩包，默认为 yaml",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "资源清单文件",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/horizontalpodautoscaler/create": {
+            "post": {
+                "description": "为 Deployment 或 StatefulSet 对象创建 HorizontalPodAutoscaler，根据 CPU 和内存的平均使用率在 minReplicas 和 maxReplicas 之间自动伸缩. 使用率相对于容器的 requests 计算，所以伸缩对象的所有容器都必须设置了对应资源的 requests.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建 HorizontalPodAutoscaler 对象",
+                "parameters": [
+                    {
+                        "description": "创建 HorizontalPodAutoscaler 对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/horizontalpodautoscaler.HorizontalPodAutoscalerRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1005,9 +1514,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/service/delete": {
+        "/resource/horizontalpodautoscaler/delete": {
             "delete": {
-                "description": "删除指定 Service 对象",
+                "description": "删除指定HorizontalPodAutoscaler对象，伸缩对象保持当前的副本数.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1017,15 +1526,15 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "删除指定 Service 对象",
+                "summary": "删除指定HorizontalPodAutoscaler对象.",
                 "parameters": [
                     {
-                        "description": "删除 Service 对象时所需的参数.",
+                        "description": "删除一个HorizontalPodAutoscaler对象时所需参数.",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/service.DeleteServiceRequest"
+                            "$ref": "#/definitions/horizontalpodautoscaler.DeleteHorizontalPodAutoscalerRequest"
                         }
                     }
                 ],
@@ -1039,9 +1548,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/service/detail/{name}/{namespace}": {
+        "/resource/horizontalpodautoscaler/detail/{name}/{namespace}": {
             "get": {
-                "description": "查询某一 Service 对象的详情",
+                "description": "查询某一 HorizontalPodAutoscaler 对象的详情，包括当前和期望的副本数、各指标的当前值与目标值以及最近的伸缩事件",
                 "consumes": [
                     "application/json"
                 ],
@@ -1051,11 +1560,11 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Service 对象的详情",
+                "summary": "查询某一 HorizontalPodAutoscaler 对象的详情",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Service 对象名称",
+                        "description": "HorizontalPodAutoscaler 对象名称",
                         "name": "name",
                         "in": "path",
                         "required": true
@@ -1066,6 +1575,18 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "事件每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "事件页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1078,13 +1599,13 @@ var doc = `{
                 }
             }
         },
-        "/resource/service/list/{namespace}": {
+        "/resource/horizontalpodautoscaler/list/{namespace}": {
             "get": {
-                "description": "获取某一用户创建的所有 Service 对象",
+                "description": "获取某一用户创建的所有 HorizontalPodAutoscaler 对象",
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取某一用户创建的所有 Service 对象",
+                "summary": "获取某一用户创建的所有 HorizontalPodAutoscaler 对象",
                 "parameters": [
                     {
                         "type": "string",
@@ -1092,6 +1613,30 @@ var doc = `{
                         "name": "namespace",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1104,9 +1649,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/service/pods/{name}/{namespace}": {
-            "get": {
-                "description": "查询某一 Service 对象对应的Pods列表",
+        "/resource/horizontalpodautoscaler/update": {
+            "put": {
+                "description": "替换 HorizontalPodAutoscaler 对象的伸缩对象、副本数范围和使用率目标，校验规则与创建时相同，已配置的伸缩行为(behavior)保持不变.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1116,26 +1661,21 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 Service 对象对应的Pods列表",
+                "summary": "更新 HorizontalPodAutoscaler 对象",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Service 对象名称",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "用户的命名空间",
-                        "name": "namespace",
-                        "in": "path",
-                        "required": true
+                        "description": "更新 HorizontalPodAutoscaler 对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/horizontalpodautoscaler.HorizontalPodAutoscalerRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
@@ -1143,9 +1683,9 @@ var doc = `{
                 }
             }
         },
-        "/resource/storageclass/detail/{name}": {
-            "get": {
-                "description": "查询某一 StorageClass 对象的详情.",
+        "/resource/ingress/create": {
+            "post": {
+                "description": "创建Ingress对象，将域名和路径转发到指定 Service 的端口. 相同域名的规则会合并到一起，tls 中的 Secret 必须与 Ingress 在同一命名空间.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1155,14 +1695,16 @@ var doc = `{
                 "tags": [
                     "resource"
                 ],
-                "summary": "查询某一 StorageClass 对象的详情.",
+                "summary": "创建Ingress对象",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "StorageClass 对象名称",
-                        "name": "name",
-                        "in": "path",
-                        "required": true
+                        "description": "创建Ingress对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingress.IngressRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1175,13 +1717,30 @@ var doc = `{
                 }
             }
         },
-        "/resource/storageclass/list": {
-            "get": {
-                "description": "获取某一用户创建的所有Job对象",
+        "/resource/ingress/delete": {
+            "delete": {
+                "description": "删除指定Ingress对象，后端的 Service 不会被删除.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "resource"
                 ],
-                "summary": "获取所有 StorageClass 对象列表.",
+                "summary": "删除指定Ingress对象.",
+                "parameters": [
+                    {
+                        "description": "删除一个Ingress对象时所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingress.DeleteIngressRequest"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
@@ -1192,9 +1751,9 @@ var doc = `{
                 }
             }
         },
-        "/v1/user": {
-            "post": {
-                "description": "创建 User 对象",
+        "/resource/ingress/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 Ingress 对象的详情，包括转发规则、TLS 配置和负载均衡地址",
                 "consumes": [
                     "application/json"
                 ],
@@ -1202,53 +1761,2744 @@ var doc = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "resource"
                 ],
-                "summary": "创建 User 对象",
+                "summary": "查询某一 Ingress 对象的详情",
                 "parameters": [
                     {
-                        "description": "创建 User 对象时所需参数",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.CreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Ingress 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
                         "schema": {
                             "$ref": "#/definitions/tool.Response"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "configmap.ConfigMapItem": {
-            "type": "object",
-            "properties": {
-                "key": {
-                    "description": "Key 配置项的 Key.",
-                    "type": "string"
-                },
-                "value": {
-                    "description": "Value 配置项的 Value. 目前只支持base64格式编码的字符串.",
-                    "type": "string"
-                }
-            }
         },
-        "configmap.CreateConfigMapRequest": {
-            "type": "object",
-            "properties": {
-                "item": {
-                    "description": "ConfigMapItems 配置项数组.",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/configmap.ConfigMapItem"
+        "/resource/ingress/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有 Ingress 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有 Ingress 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/ingress/routes/{namespace}": {
+            "get": {
+                "description": "列出命名空间中所有 Ingress 对象的域名、路径到 Service 端口的映射，并根据 Endpoints 给出后端就绪的地址数量.\nService 或端口不存在时 error 字段给出原因，ready 为 true 表示后端至少有一个就绪的地址.",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一命名空间的所有访问路由",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/ingress/update": {
+            "put": {
+                "description": "使用请求中的转发规则、TLS 配置和 IngressClass 替换 Ingress 对象中原有的配置，默认后端和标签、注解保持不变.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "更新Ingress对象",
+                "parameters": [
+                    {
+                        "description": "更新Ingress对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ingress.IngressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/job/create": {
+            "post": {
+                "description": "创建Job对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建Job对象",
+                "parameters": [
+                    {
+                        "description": "创建Job对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/job.CreateJobRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/job/delete": {
+            "delete": {
+                "description": "删除指定Job对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除指定Job对象",
+                "parameters": [
+                    {
+                        "description": "删除参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/job.DeleteJobRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/job/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一Job对象的详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一Job对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/job/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有Job对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有Job对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/job/pods/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一Job对象控制的Pods列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一Job对象控制的Pods列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Job对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/logs/{kind}/{name}/{namespace}": {
+            "get": {
+                "description": "将 get 请求升级为 WebSocket 协议，同时跟踪 Deployment、Job 或 Service 对象所有 Pod 中所有容器的日志，日志按时间戳合并后推送. 滚动更新过程中新建和删除的 Pod 会被自动跟踪和移除.",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "实时获取某一工作负载所有 Pod 的 Logs.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型，可选值为 deployment、job 和 service",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每个已运行的容器从最后多少行日志开始推送，默认为 10",
+                        "name": "tailLines",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"pod\":\"\",\"container\":\"\",\"timestamp\":\"\",\"content\":\"\"}",
+                        "schema": {
+                            "$ref": "#/definitions/container.AggregatedLogLine"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/namespace/create": {
+            "post": {
+                "description": "创建命名空间，并根据配置文件中的预设创建 ResourceQuota 和 LimitRange，可同时将命名空间分配给用户，仅管理员可调用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建命名空间",
+                "parameters": [
+                    {
+                        "description": "创建命名空间时所需参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/namespace.CreateNamespaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/namespace.CreateNamespaceResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/namespace/delete": {
+            "delete": {
+                "description": "删除命名空间及其中的所有资源，并收回所有用户对该命名空间的访问权限，仅管理员可调用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除命名空间",
+                "parameters": [
+                    {
+                        "description": "删除参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/namespace.DeleteNamespaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/namespace/detail/{namespace}": {
+            "get": {
+                "description": "查询某一命名空间的详情，包括其中的 ResourceQuota 和 LimitRange",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一命名空间的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/namespace/list": {
+            "get": {
+                "description": "管理员获取集群中的所有命名空间，其他用户只能获取自己拥有的命名空间",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取集群中的命名空间列表",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,default",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/cordon/{name}": {
+            "put": {
+                "description": "将 Node 对象标记为不可调度，已运行的 Pod 不受影响，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "将 Node 对象标记为不可调度",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/detail/{name}": {
+            "get": {
+                "description": "查询某一 Node 对象的详情，包括已分配的资源请求和限制、Pod 和事件，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 Node 对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/drain/{name}": {
+            "put": {
+                "description": "将 Node 对象标记为不可调度并通过 Eviction API 驱逐其上的 Pod，驱逐遵循 PodDisruptionBudget.\nDaemonSet 管理的 Pod 和静态 Pod 不会被驱逐. 存在不受控制器管理或使用 emptyDir 的 Pod 时，除非指定 force 或 deleteLocalData，否则不驱逐任何 Pod.\n驱逐失败时返回错误原因和失败前已驱逐、跳过的 Pod. 请求体为空时使用默认参数. 仅管理员可调用.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "驱逐 Node 对象上的 Pod",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "驱逐参数",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/node.DrainNodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"evicted\":[],\"skipped\":[]}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/list": {
+            "get": {
+                "description": "获取集群中的所有 Node 对象，包括节点的容量和已分配的资源，仅管理员可调用",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取集群中的所有 Node 对象",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,node-1",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/pods/{name}": {
+            "get": {
+                "description": "获取运行在某一 Node 对象上的所有 Pod 对象，仅管理员可调用",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取运行在某一 Node 对象上的所有 Pod 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/node/uncordon/{name}": {
+            "put": {
+                "description": "将 Node 对象恢复为可调度，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "将 Node 对象恢复为可调度",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Node 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/persistentvolumeclaim/create": {
+            "post": {
+                "description": "创建PersistentVolumeClaim对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建PersistentVolumeClaim对象",
+                "parameters": [
+                    {
+                        "description": "创建PersistentVolumeClaim对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/persistentvolumeclaim.CreatePersistentVolumeClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/persistentvolumeclaim/delete": {
+            "delete": {
+                "description": "删除指定的PersistentVolumeClaim对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除指定的PersistentVolumeClaim对象",
+                "parameters": [
+                    {
+                        "description": "删除参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/persistentvolumeclaim.DeletePersistentVolumeClaimRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/persistentvolumeclaim/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一PersistentVolumeClaim对象的详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一PersistentVolumeClaim对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "PersistentVolumeClaim对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/persistentvolumeclaim/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有PersistentVolumeClaim对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有PersistentVolumeClaim对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/pod/container/{podId}/{namespace}": {
+            "get": {
+                "description": "获取某一 Pod 中的所有容器对象.",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一 Pod 中的所有容器对象.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pod ID",
+                        "name": "podId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/pod/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 Pod 对象的详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 Pod 对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Pod 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/pod/list/{namespace}": {
+            "get": {
+                "description": "获取某一命名空间下的所有 Pod 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一命名空间下的所有 Pod 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/raw/{kind}/{namespace}/{name}": {
+            "get": {
+                "description": "按资源类型查询对象的完整定义，支持内置资源类型和 CRD，资源类型为单数小写形式，如 ingress、horizontalpodautoscaler，CRD 使用其完整名称.\n集群级别的对象使用 _cluster 作为命名空间，只有管理员可以访问. format 为 yaml 时直接返回 YAML 格式的对象.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询任意类型的对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间，集群级别的对象为 _cluster",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "返回格式，可选值为 json 和 yaml，默认为 json",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"demo\",\"namespace\":\"default\"}}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "使用请求体中 JSON 或 YAML 格式的对象替换已存在的对象，请求体中的 metadata.resourceVersion 用于冲突检测，返回更新后的对象.\n集群级别的对象使用 _cluster 作为命名空间，只有管理员可以修改.",
+                "consumes": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "produces": [
+                    "application/json",
+                    "application/yaml"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "替换任意类型的对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间，集群级别的对象为 _cluster",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "返回格式，可选值为 json 和 yaml，默认为 json",
+                        "name": "format",
+                        "in": "query"
+                    },
+                    {
+                        "description": "对象的完整定义",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"demo\",\"namespace\":\"default\"}}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "按资源类型删除对象，依赖于该对象的子对象会被级联删除.\n集群级别的对象使用 _cluster 作为命名空间，只有管理员可以删除.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除任意类型的对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间，集群级别的对象为 _cluster",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":null}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/scale/{kind}/{name}/{namespace}": {
+            "get": {
+                "description": "查询 Deployment、StatefulSet、ReplicaSet 或 ReplicationController 对象的期望副本数和实际副本数.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询工作负载的副本数.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型，可选值为 deployment、statefulset、replicaset 和 replicationcontroller",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"desiredReplicas\":1,\"actualReplicas\":1}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "调整 Deployment、StatefulSet、ReplicaSet 或 ReplicationController 对象的副本数.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "调整工作负载的副本数.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "资源类型，可选值为 deployment、statefulset、replicaset 和 replicationcontroller",
+                        "name": "kind",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "期望的副本数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/scale.ScaleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"desiredReplicas\":1,\"actualReplicas\":1}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/secret/create": {
+            "post": {
+                "description": "创建 Secret 对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建 Secret 对象",
+                "parameters": [
+                    {
+                        "description": "创建 Secret 对象时所需参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/secret.CreateSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/secret/delete": {
+            "delete": {
+                "description": "删除指定Secret对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除指定Secret对象",
+                "parameters": [
+                    {
+                        "description": "删除参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/secret.DeleteSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/secret/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 Secret 对象的详情",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 Secret 对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Secret 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/secret/list/{namespace}": {
+            "get": {
+                "description": "获取某一命名空间下的所有 Secret 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一命名空间下的所有 Secret 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/create": {
+            "post": {
+                "description": "创建 ClusterIP、NodePort、LoadBalancer 或 headless 类型的 Service 对象. 选择器必须匹配命名空间中至少一个 Pod，nodePort 必须在集群配置的 NodePort 范围内且没有被其他 Service 使用，LoadBalancer 类型不支持 UDP 协议.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建 Service 对象",
+                "parameters": [
+                    {
+                        "description": "创建 Service 对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.ServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/delete": {
+            "delete": {
+                "description": "删除指定 Service 对象",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除指定 Service 对象",
+                "parameters": [
+                    {
+                        "description": "删除 Service 对象时所需的参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.DeleteServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 Service 对象的详情，endpointList 中列出流量转发到的后端地址及其是否就绪",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 Service 对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有 Service 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有 Service 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/pods/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 Service 对象对应的Pods列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 Service 对象对应的Pods列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Service 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/service/update": {
+            "put": {
+                "description": "替换 Service 对象的类型、选择器和端口，校验规则与创建时相同. 未指定 nodePort 的端口保留原来分配的 nodePort，Service 不能在 headless 与非 headless 之间切换.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "更新 Service 对象",
+                "parameters": [
+                    {
+                        "description": "更新 Service 对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/service.ServiceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/create": {
+            "post": {
+                "description": "创建StatefulSet对象和同名的Headless Service对象，同名的Service对象已存在时直接使用，命名空间必须已经存在. VolumeClaimTemplates 中的每个模板会为每个 Pod 创建一个 PersistentVolumeClaim 对象并挂载到容器中",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "创建StatefulSet对象",
+                "parameters": [
+                    {
+                        "description": "创建StatefulSet对象所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/statefulset.CreateStatefulSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/delete": {
+            "delete": {
+                "description": "删除指定StatefulSet对象及其管理的Pod，以及创建StatefulSet对象时一起创建的Headless Service对象，可以选择同时删除由 VolumeClaimTemplates 创建的 PersistentVolumeClaim 对象，默认保留.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "删除指定StatefulSet对象.",
+                "parameters": [
+                    {
+                        "description": "删除一个StatefulSet对象时所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/statefulset.DeleteStatefulSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"persistentVolumeClaims\":[\"data-db-0\"]}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/detail/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 StatefulSet 对象的详情，包括 Pod 状态、更新策略和滚动更新进度",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 StatefulSet 对象的详情",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/events/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 StatefulSet 对象及其管理的 Pod 的事件，如 PersistentVolumeClaim 绑定失败等",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 StatefulSet 对象的事件列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,lastSeen",
+                        "name": "sortBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/list/{namespace}": {
+            "get": {
+                "description": "获取某一用户创建的所有 StatefulSet 对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取某一用户创建的所有 StatefulSet 对象",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/pods/{name}/{namespace}": {
+            "get": {
+                "description": "查询某一 StatefulSet 对象控制的Pods列表",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 StatefulSet 对象控制的Pods列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StatefulSet 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "用户的命名空间",
+                        "name": "namespace",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/statefulset/update": {
+            "put": {
+                "description": "更新StatefulSet对象中指定容器的镜像，只有序号大于等于 partition 的 Pod 会被更新. 逐步调小 partition 直到 0 即可完成全部 Pod 的更新.\ncontainer 为空时只修改 partition，partition 为空时保持原有的值.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "分批滚动更新StatefulSet对象.",
+                "parameters": [
+                    {
+                        "description": "滚动更新StatefulSet对象时所需参数.",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/statefulset.UpdateStatefulSetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/storageclass/detail/{name}": {
+            "get": {
+                "description": "查询某一 StorageClass 对象的详情.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "resource"
+                ],
+                "summary": "查询某一 StorageClass 对象的详情.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "StorageClass 对象名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200, \"message\":\"OK\", \"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/resource/storageclass/list": {
+            "get": {
+                "description": "获取某一用户创建的所有Job对象",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "获取所有 StorageClass 对象列表.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "每页数量",
+                        "name": "itemsPerPage",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "页码，从 1 开始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "排序条件，例如 d,creationTimestamp",
+                        "name": "sortBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "过滤条件，例如 name,nginx",
+                        "name": "filterBy",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":200,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cluster": {
+            "get": {
+                "description": "获取所有已注册的 Kubernetes 集群",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster"
+                ],
+                "summary": "获取所有已注册的 Kubernetes 集群",
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "添加 Kubernetes 集群，添加前会测试集群的连通性. kubeconfig 中的证书和凭证必须以内联数据的形式提供，\n不支持 exec、auth-provider 插件以及 client-certificate、token-file 等文件路径. kubeconfig 加密后保存到数据库.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster"
+                ],
+                "summary": "添加 Kubernetes 集群",
+                "parameters": [
+                    {
+                        "description": "添加集群时所需参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cluster.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cluster/{name}": {
+            "delete": {
+                "description": "移除 Kubernetes 集群，默认集群不可移除",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster"
+                ],
+                "summary": "移除 Kubernetes 集群",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "集群名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/cluster/{name}/test": {
+            "get": {
+                "description": "测试 Kubernetes 集群的连通性并返回集群版本",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cluster"
+                ],
+                "summary": "测试 Kubernetes 集群的连通性",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "集群名称",
+                        "name": "name",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/login": {
+            "post": {
+                "description": "校验用户名和密码，成功后返回访问其他接口时所需的 token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "用户登录",
+                "parameters": [
+                    {
+                        "description": "用户名和密码",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"token\":\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/me": {
+            "get": {
+                "description": "获取当前登录用户的信息，包括是否为管理员和拥有的命名空间",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取当前登录用户的信息",
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/terminal/{id}": {
+            "get": {
+                "description": "将 get 请求升级为 WebSocket 协议并连接由 /resource/container/shell 创建的终端会话，会话只能由创建者连接一次.\n消息格式为 {\"Op\":\"\",\"Data\":\"\",\"Rows\":0,\"Cols\":0}，客户端发送 stdin 和 resize 消息，服务端发送 stdout 和 toast 消息.\n超过 terminal.idle_timeout（默认 10 分钟）没有输入时关闭终端.",
+                "tags": [
+                    "resource"
+                ],
+                "summary": "连接终端会话.",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "终端会话 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "WebSocket 请求无法设置 Authorization 头时使用的 token",
+                        "name": "token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "{\"Op\":\"stdout\",\"Data\":\"\"}",
+                        "schema": {
+                            "$ref": "#/definitions/terminal.TerminalMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user": {
+            "get": {
+                "description": "按用户名模糊查询用户列表，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取用户列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名关键字",
+                        "name": "username",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "偏移量",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "返回的最大记录数",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"totalCount\":1,\"userList\":[]}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "创建 User 对象，只有管理员可以创建用户",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "创建 User 对象",
+                "parameters": [
+                    {
+                        "description": "创建 User 对象时所需参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.CreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/{username}": {
+            "get": {
+                "description": "获取用户信息，普通用户只能获取自己的信息",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取用户信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "更新用户密码，普通用户只能更新自己的信息",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "更新用户信息",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "更新用户信息时所需参数",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.UpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "删除用户并收回用户拥有的全部命名空间，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "删除用户",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/user/{username}/namespace": {
+            "get": {
+                "description": "获取用户拥有的命名空间列表，仅管理员可调用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "获取用户拥有的命名空间列表",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "为用户分配命名空间，用户只能访问自己拥有的命名空间，仅管理员可调用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "为用户分配命名空间",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "集群名称和命名空间",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.NamespaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "收回用户的命名空间，仅管理员可调用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "收回用户的命名空间",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "用户名",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "集群名称和命名空间",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.NamespaceRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "{\"code\":0,\"message\":\"OK\",\"data\":{\"\"}}",
+                        "schema": {
+                            "$ref": "#/definitions/tool.Response"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "api.ObjectMeta": {
+            "type": "object",
+            "properties": {
+                "annotations": {
+                    "description": "Annotations are unstructured key value data stored with a resource that may be set by\nexternal tooling. They are not queryable and should be preserved when modifying\nobjects.  Annotation keys have the same formatting restrictions as Label keys. See the\ncomments on Labels for details.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "creationTimestamp": {
+                    "description": "CreationTimestamp is a timestamp representing the server time when this object was\ncreated. It is not guaranteed to be set in happens-before order across separate operations.\nClients may not set this value. It is represented in RFC3339 form and is in UTC.",
+                    "type": "string"
+                },
+                "labels": {
+                    "description": "Labels are key value pairs that may be used to scope and select individual resources.\nLabel keys are of the form:\n    label-key ::= prefixed-name | name\n    prefixed-name ::= prefix '/' name\n    prefix ::= DNS_SUBDOMAIN\n    name ::= DNS_LABEL\nThe prefix is optional.  If the prefix is not specified, the key is assumed to be private\nto the user.  Other system components that wish to use labels must specify a prefix.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "Name is unique within a namespace. Name is primarily intended for creation\nidempotence and configuration definition.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace defines the space within which name must be unique. An empty namespace is\nequivalent to the \"default\" namespace, but \"default\" is the canonical representation.\nNot all objects are required to be scoped to a namespace - the value of this field for\nthose objects will be empty.",
+                    "type": "string"
+                },
+                "uid": {
+                    "description": "UID is a type that holds unique ID values, including UUIDs.  Because we\ndon't ONLY use UUIDs, this is an alias to string.  Being a type captures\nintent and helps make sure that UIDs and names do not get conflated.",
+                    "type": "string"
+                }
+            }
+        },
+        "api.TypeMeta": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "description": "Kind is a string value representing the REST resource this object represents.\nServers may infer this from the endpoint the client submits requests to.\nIn smalllettercase.\nMore info: http://releases.k8s.io/HEAD/docs/devel/api-conventions.md#types-kinds",
+                    "type": "string"
+                },
+                "scalable": {
+                    "description": "Scalable represents whether or not an object is scalable.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "apply.ApplyRequest": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "Content YAML 或 JSON 格式的资源清单，多个对象之间用 --- 分隔. 以 multipart/form-data 上传 file 时可以为空.",
+                    "type": "string"
+                },
+                "dryRun": {
+                    "description": "DryRun 为 true 时由 apiserver 校验请求但不保存对象.",
+                    "type": "boolean"
+                },
+                "namespace": {
+                    "description": "Namespace 覆盖清单中所有命名空间级别对象的命名空间，为空时使用对象自身的命名空间，默认为 default.",
+                    "type": "string"
+                },
+                "validateOnly": {
+                    "description": "ValidateOnly 为 true 时只解析清单并检查资源类型，不访问 apiserver 的资源接口.",
+                    "type": "boolean"
+                }
+            }
+        },
+        "cluster.CreateRequest": {
+            "type": "object",
+            "properties": {
+                "apiserverHost": {
+                    "description": "APIServerHost apiserver 地址，格式为 'protocol://address:port'.",
+                    "type": "string"
+                },
+                "burst": {
+                    "description": "Burst 客户端限流参数.",
+                    "type": "integer"
+                },
+                "context": {
+                    "description": "Context 使用的 kubeconfig context，为空时使用 current-context.",
+                    "type": "string"
+                },
+                "kubeconfig": {
+                    "description": "KubeConfig kubeconfig 文件内容.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name 集群名称.",
+                    "type": "string"
+                },
+                "qps": {
+                    "description": "QPS 客户端限流参数.",
+                    "type": "number"
+                }
+            }
+        },
+        "common.Event": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "description": "The number of times this event has occurred.",
+                    "type": "integer"
+                },
+                "firstSeen": {
+                    "description": "The time at which the event was first recorded.",
+                    "type": "string"
+                },
+                "lastSeen": {
+                    "description": "The time at which the most recent occurrence of this event was recorded.",
+                    "type": "string"
+                },
+                "message": {
+                    "description": "A human-readable description of the status of related object.",
+                    "type": "string"
+                },
+                "object": {
+                    "description": "Reference to a piece of an object, which triggered an event. For example\n\"spec.containers{name}\" refers to container within pod with given name, if no container\nname is specified, for example \"spec.containers[2]\", then it refers to container with\nindex 2 in this pod.",
+                    "type": "string"
+                },
+                "objectMeta": {
+                    "type": "object",
+                    "$ref": "#/definitions/api.ObjectMeta"
+                },
+                "reason": {
+                    "description": "Short, machine understandable string that gives the reason\nfor this event being generated.",
+                    "type": "string"
+                },
+                "sourceComponent": {
+                    "description": "Component from which the event is generated.",
+                    "type": "string"
+                },
+                "sourceHost": {
+                    "description": "Host name on which the event is generated.",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Event type (at the moment only normal and warning are supported).",
+                    "type": "string"
+                },
+                "typeMeta": {
+                    "type": "object",
+                    "$ref": "#/definitions/api.TypeMeta"
+                }
+            }
+        },
+        "configmap.ConfigMapItem": {
+            "type": "object",
+            "properties": {
+                "key": {
+                    "description": "Key 配置项的 Key.",
+                    "type": "string"
+                },
+                "value": {
+                    "description": "Value 配置项的 Value. 目前只支持base64格式编码的字符串.",
+                    "type": "string"
+                }
+            }
+        },
+        "configmap.CreateConfigMapRequest": {
+            "type": "object",
+            "properties": {
+                "item": {
+                    "description": "ConfigMapItems 配置项数组.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/configmap.ConfigMapItem"
                     }
                 },
                 "name": {
@@ -1261,11 +4511,78 @@ var doc = `{
                 }
             }
         },
-        "configmap.DeleteConfigMapRequest": {
+        "configmap.DeleteConfigMapRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "ConfigMap 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "container.AggregatedLogLine": {
+            "type": "object",
+            "properties": {
+                "container": {
+                    "description": "Container 名称.",
+                    "type": "string"
+                },
+                "content": {
+                    "description": "Content 日志内容.",
+                    "type": "string"
+                },
+                "pod": {
+                    "description": "Pod 名称.",
+                    "type": "string"
+                },
+                "time": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "description": "Timestamp 日志时间戳.",
+                    "type": "string"
+                }
+            }
+        },
+        "cronjob.CreateCronJobRequest": {
+            "type": "object",
+            "properties": {
+                "cronjob": {
+                    "type": "object",
+                    "$ref": "#/definitions/cronjob.CronJobArgs"
+                },
+                "name": {
+                    "description": "Name CronJob 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "cronjob.CronJobArgs": {
+            "type": "object",
+            "properties": {
+                "jobTemplate": {
+                    "type": "object",
+                    "$ref": "#/definitions/model.JobArgs"
+                },
+                "schedule": {
+                    "description": "The schedule in Cron format, see https://en.wikipedia.org/wiki/Cron.",
+                    "type": "string"
+                }
+            }
+        },
+        "cronjob.DeleteCronJobRequest": {
             "type": "object",
             "properties": {
                 "name": {
-                    "description": "ConfigMap 对象名称.",
+                    "description": "CronJob 对象名称.",
                     "type": "string"
                 },
                 "namespace": {
@@ -1274,15 +4591,16 @@ var doc = `{
                 }
             }
         },
-        "cronjob.CreateCronJobRequest": {
+        "daemonset.CreateDaemonSetRequest": {
             "type": "object",
             "properties": {
-                "cronjob": {
+                "daemonSet": {
+                    "description": "DaemonSet daemonset对象参数.",
                     "type": "object",
-                    "$ref": "#/definitions/cronjob.CronJobArgs"
+                    "$ref": "#/definitions/model.DaemonSetArgs"
                 },
                 "name": {
-                    "description": "Name CronJob 对象名称.",
+                    "description": "DaemonSet 对象名称.",
                     "type": "string"
                 },
                 "namespace": {
@@ -1291,29 +4609,45 @@ var doc = `{
                 }
             }
         },
-        "cronjob.CronJobArgs": {
+        "daemonset.DeleteDaemonSetRequest": {
             "type": "object",
             "properties": {
-                "jobTemplate": {
-                    "type": "object",
-                    "$ref": "#/definitions/model.JobArgs"
+                "name": {
+                    "description": "Name DaemonSet对象名称.",
+                    "type": "string"
                 },
-                "schedule": {
-                    "description": "The schedule in Cron format, see https://en.wikipedia.org/wiki/Cron.",
+                "namespace": {
+                    "description": "Namespace 命名空间.",
                     "type": "string"
                 }
             }
         },
-        "cronjob.DeleteCronJobRequest": {
+        "daemonset.UpdateDaemonSetRequest": {
             "type": "object",
             "properties": {
+                "container": {
+                    "description": "Container whose image is replaced, the image is not changed if it is empty.",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "New image of the container.",
+                    "type": "string"
+                },
+                "maxUnavailable": {
+                    "description": "The maximum number of pods that can be unavailable during a rolling update, can be an\nabsolute number (ex: 5) or a percentage of the scheduled pods (ex: 10%).",
+                    "type": "string"
+                },
                 "name": {
-                    "description": "CronJob 对象名称.",
+                    "description": "Name DaemonSet对象名称.",
                     "type": "string"
                 },
                 "namespace": {
                     "description": "Namespace 命名空间.",
                     "type": "string"
+                },
+                "strategyType": {
+                    "description": "Update strategy, RollingUpdate or OnDelete. The strategy is not changed if it is empty.",
+                    "type": "string"
                 }
             }
         },
@@ -1334,6 +4668,24 @@ var doc = `{
                 }
             }
         },
+        "deployment.CreateDeploymentRequest": {
+            "type": "object",
+            "properties": {
+                "deployment": {
+                    "description": "Deployment deployment对象参数.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.DeploymentArgs"
+                },
+                "name": {
+                    "description": "Deployment 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
         "deployment.DeleteDeploymentRequest": {
             "type": "object",
             "properties": {
@@ -1390,33 +4742,356 @@ var doc = `{
                 }
             }
         },
+        "deployment.PortMapping": {
+            "type": "object",
+            "properties": {
+                "port": {
+                    "description": "Port that will be exposed on the service.",
+                    "type": "integer"
+                },
+                "protocol": {
+                    "description": "IP protocol for the mapping, e.g., \"TCP\" or \"UDP\".",
+                    "type": "string"
+                },
+                "targetPort": {
+                    "description": "Docker image path for the application.",
+                    "type": "integer"
+                }
+            }
+        },
+        "deployment.RollbackRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name Deployment对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                },
+                "revision": {
+                    "description": "Revision 回滚的目标版本，为 0 时回滚到低于当前版本的最高版本.",
+                    "type": "integer"
+                }
+            }
+        },
+        "deployment.UpdateImageRequest": {
+            "type": "object",
+            "properties": {
+                "container": {
+                    "description": "Container 容器名称.",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "Image 新的容器镜像.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name Deployment对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "event.EventMessage": {
+            "type": "object",
+            "properties": {
+                "event": {
+                    "description": "Event 事件.",
+                    "type": "object",
+                    "$ref": "#/definitions/common.Event"
+                },
+                "type": {
+                    "description": "Type 事件的变化类型，ADDED 表示新事件，MODIFIED 表示事件再次发生.",
+                    "type": "string"
+                }
+            }
+        },
+        "horizontalpodautoscaler.DeleteHorizontalPodAutoscalerRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name HorizontalPodAutoscaler对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "horizontalpodautoscaler.HorizontalPodAutoscalerRequest": {
+            "type": "object",
+            "properties": {
+                "maxReplicas": {
+                    "description": "Upper limit of the number of pods.",
+                    "type": "integer"
+                },
+                "minReplicas": {
+                    "description": "Lower limit of the number of pods, defaults to 1.",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "Name HorizontalPodAutoscaler 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                },
+                "scaleTargetRef": {
+                    "description": "Workload that is scaled, the kind is Deployment or StatefulSet.",
+                    "type": "object",
+                    "$ref": "#/definitions/horizontalpodautoscaler.ScaleTargetRef"
+                },
+                "targetCPUUtilizationPercentage": {
+                    "description": "Target average CPU utilization in percent of the requested CPU of the pods.",
+                    "type": "integer"
+                },
+                "targetMemoryUtilizationPercentage": {
+                    "description": "Target average memory utilization in percent of the requested memory of the pods.",
+                    "type": "integer"
+                }
+            }
+        },
+        "horizontalpodautoscaler.ScaleTargetRef": {
+            "type": "object",
+            "properties": {
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "ingress.DeleteIngressRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name Ingress对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "ingress.IngressRequest": {
+            "type": "object",
+            "properties": {
+                "ingressClassName": {
+                    "description": "Name of the ingress class, the default class of the cluster is used when it is nil.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name Ingress 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                },
+                "rules": {
+                    "description": "Rules of the ingress, at least one is required.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ingress.RuleSpec"
+                    }
+                },
+                "tls": {
+                    "description": "TLS configuration of the ingress.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ingress.TLSSpec"
+                    }
+                }
+            }
+        },
+        "ingress.RuleSpec": {
+            "type": "object",
+            "properties": {
+                "host": {
+                    "description": "Host matched by the rule, all hosts when it is empty.",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "Path matched by the rule, all paths when it is empty.",
+                    "type": "string"
+                },
+                "pathType": {
+                    "description": "PathType is one of Exact, Prefix or ImplementationSpecific, defaults to the ingress\ncontroller behaviour when it is empty.",
+                    "type": "string"
+                },
+                "serviceName": {
+                    "description": "Name of the backend service.",
+                    "type": "string"
+                },
+                "servicePort": {
+                    "description": "Port number or name of the backend service.",
+                    "type": "string"
+                }
+            }
+        },
+        "ingress.TLSSpec": {
+            "type": "object",
+            "properties": {
+                "hosts": {
+                    "description": "Hosts covered by the certificate.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secretName": {
+                    "description": "Name of the kubernetes.io/tls secret in the namespace of the ingress.",
+                    "type": "string"
+                }
+            }
+        },
         "job.CreateJobRequest": {
             "type": "object",
             "properties": {
-                "jobTemplate": {
-                    "description": "Job 对象参数.",
+                "jobTemplate": {
+                    "description": "Job 对象参数.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.JobArgs"
+                },
+                "name": {
+                    "description": "Name Job 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "job.DeleteJobRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Job 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "logs.LogLine": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "timestamp": {
+                    "type": "string"
+                }
+            }
+        },
+        "model.DaemonSetArgs": {
+            "type": "object",
+            "properties": {
+                "nodeSelector": {
+                    "description": "Labels of the nodes the pods are scheduled on, all nodes when it is empty.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.Label"
+                    }
+                },
+                "podTemplate": {
+                    "description": "PodTemplate 定义了 DaemonSet 对象管理的 Pod 对象的定义参数.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.PodArgs"
+                },
+                "selector": {
+                    "description": "Label selector for pods. The selector labels are added to the pod labels,\ndefaults to the pod labels when it is empty.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.Label"
+                    }
+                },
+                "strategy": {
+                    "description": "The daemon set strategy to use to replace existing pods with new ones.\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/model.DaemonSetStrategyArgs"
+                },
+                "tolerations": {
+                    "description": "Tolerations of the pods, e.g. to run on the master nodes.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "model.DaemonSetStrategyArgs": {
+            "type": "object",
+            "properties": {
+                "maxUnavailable": {
+                    "description": "The maximum number of pods that can be unavailable during the rolling update,\ncan be an absolute number (ex: 5) or a percentage of scheduled pods (ex: 10%).\n+optional",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type of daemon set update. Can be \"RollingUpdate\" or \"OnDelete\". Default is RollingUpdate.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "model.DeploymentArgs": {
+            "type": "object",
+            "properties": {
+                "isExternal": {
+                    "description": "Whether the created service is external.\n+optional",
+                    "type": "boolean"
+                },
+                "podTemplate": {
+                    "description": "PodTemplate 定义了 Deployment 对象管理的 Pod 对象的定义参数.",
                     "type": "object",
-                    "$ref": "#/definitions/model.JobArgs"
+                    "$ref": "#/definitions/model.PodArgs"
                 },
-                "name": {
-                    "description": "Name Job 对象名称.",
-                    "type": "string"
+                "portMappings": {
+                    "description": "Port mappings for the service that is created together with the deployment.\nThe service is created if there is at least one port mapping.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.PortMapping"
+                    }
                 },
-                "namespace": {
-                    "description": "Namespace 命名空间.",
-                    "type": "string"
+                "replicas": {
+                    "description": "Number of desired pods. Defaults to 1.\n+optional",
+                    "type": "integer"
+                },
+                "selector": {
+                    "description": "Label selector for pods. The selector labels are added to the pod labels,\ndefaults to the pod labels when it is empty.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.Label"
+                    }
+                },
+                "strategy": {
+                    "description": "The deployment strategy to use to replace existing pods with new ones.\n+optional",
+                    "type": "object",
+                    "$ref": "#/definitions/model.DeploymentStrategyArgs"
                 }
             }
         },
-        "job.DeleteJobRequest": {
+        "model.DeploymentStrategyArgs": {
             "type": "object",
             "properties": {
-                "name": {
-                    "description": "Job 对象名称.",
+                "maxSurge": {
+                    "description": "The maximum number of pods that can be scheduled above the desired number of\npods during the rolling update, can be an absolute number (ex: 5) or a\npercentage of desired pods (ex: 10%).\n+optional",
                     "type": "string"
                 },
-                "namespace": {
-                    "description": "Namespace 命名空间.",
+                "maxUnavailable": {
+                    "description": "The maximum number of pods that can be unavailable during the rolling update,\ncan be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).\n+optional",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "Type of deployment. Can be \"Recreate\" or \"RollingUpdate\". Default is RollingUpdate.\n+optional",
                     "type": "string"
                 }
             }
@@ -1506,6 +5181,177 @@ var doc = `{
                 }
             }
         },
+        "model.ServiceArgs": {
+            "type": "object",
+            "properties": {
+                "headless": {
+                    "description": "Whether the service is headless, i.e. has no cluster IP. Only valid for ClusterIP services.\n+optional",
+                    "type": "boolean"
+                },
+                "ports": {
+                    "description": "Ports 定义了 Service 对象的端口映射.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.ServicePortArgs"
+                    }
+                },
+                "selector": {
+                    "description": "Labels of the pods the traffic is routed to, must match at least one pod.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.Label"
+                    }
+                },
+                "type": {
+                    "description": "Type of the service, one of ClusterIP, NodePort and LoadBalancer. Defaults to ClusterIP.\n+optional",
+                    "type": "string"
+                }
+            }
+        },
+        "model.ServicePortArgs": {
+            "type": "object",
+            "properties": {
+                "nodePort": {
+                    "description": "Port on each node for NodePort and LoadBalancer services, allocated by the apiserver\nwhen it is zero.\n+optional",
+                    "type": "integer"
+                }
+            }
+        },
+        "model.StatefulSetArgs": {
+            "type": "object",
+            "properties": {
+                "podManagementPolicy": {
+                    "description": "Whether the pods are created and deleted one by one (OrderedReady) or all at once\n(Parallel). Default is OrderedReady.\n+optional",
+                    "type": "string"
+                },
+                "podTemplate": {
+                    "description": "PodTemplate 定义了 StatefulSet 对象管理的 Pod 对象的定义参数.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.PodArgs"
+                },
+                "portMappings": {
+                    "description": "Ports of the headless service that is created together with the stateful set.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.PortMapping"
+                    }
+                },
+                "replicas": {
+                    "description": "Number of desired pods. Defaults to 1.\n+optional",
+                    "type": "integer"
+                },
+                "selector": {
+                    "description": "Label selector for pods. The selector labels are added to the pod labels,\ndefaults to the pod labels when it is empty.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/deployment.Label"
+                    }
+                },
+                "volumeClaimTemplates": {
+                    "description": "VolumeClaimTemplates 定义了为每个 Pod 创建的 PersistentVolumeClaim 对象.\n+optional",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.VolumeClaimTemplateArgs"
+                    }
+                }
+            }
+        },
+        "model.VolumeClaimTemplateArgs": {
+            "type": "object",
+            "properties": {
+                "AccessModes": {
+                    "description": "AccessModes 存储的访问模式.",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "mountPath": {
+                    "description": "MountPath 持久化存储挂载路径.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name 模板名称，同时也是容器中存储卷的名称.",
+                    "type": "string"
+                },
+                "readOnly": {
+                    "description": "ReadOnly",
+                    "type": "boolean"
+                },
+                "storageCapacity": {
+                    "description": "StorageCapacity 申请存储容量.",
+                    "type": "number"
+                },
+                "storageClassName": {
+                    "description": "StoraegClassName 存储类名称.",
+                    "type": "string"
+                }
+            }
+        },
+        "namespace.CreateNamespaceRequest": {
+            "type": "object",
+            "properties": {
+                "limitRangePreset": {
+                    "description": "LimitRangePreset 资源限制预设名称，对应配置文件中的 namespace.limit_range_presets，为空时不创建 LimitRange.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name 命名空间名称.",
+                    "type": "string"
+                },
+                "owner": {
+                    "description": "Owner 命名空间的拥有者，为空时只有管理员可以访问该命名空间.",
+                    "type": "string"
+                },
+                "quotaPreset": {
+                    "description": "QuotaPreset 资源配额预设名称，对应配置文件中的 namespace.quota_presets，为空时不创建 ResourceQuota.",
+                    "type": "string"
+                }
+            }
+        },
+        "namespace.CreateNamespaceResponse": {
+            "type": "object",
+            "properties": {
+                "limitRange": {
+                    "description": "LimitRange 根据预设创建的 LimitRange 对象，未指定预设时为空.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 创建的命名空间.",
+                    "type": "string"
+                },
+                "resourceQuota": {
+                    "description": "ResourceQuota 根据预设创建的 ResourceQuota 对象，未指定预设时为空.",
+                    "type": "string"
+                }
+            }
+        },
+        "namespace.DeleteNamespaceRequest": {
+            "type": "object",
+            "properties": {
+                "namespace": {
+                    "description": "Namespace 命名空间名称.",
+                    "type": "string"
+                }
+            }
+        },
+        "node.DrainNodeRequest": {
+            "type": "object",
+            "properties": {
+                "deleteLocalData": {
+                    "description": "DeleteLocalData evicts pods that use emptyDir volumes, the data of those volumes is lost.",
+                    "type": "boolean"
+                },
+                "force": {
+                    "description": "Force evicts pods that are not managed by a controller. Such pods are not recreated.",
+                    "type": "boolean"
+                },
+                "timeoutSeconds": {
+                    "description": "TimeoutSeconds 驱逐被 PodDisruptionBudget 拒绝时的最长重试时间，默认为 60 秒，最长为 600 秒.",
+                    "type": "integer"
+                }
+            }
+        },
         "persistentvolumeclaim.CreatePersistentVolumeClaimRequest": {
             "type": "object",
             "properties": {
@@ -1547,6 +5393,15 @@ var doc = `{
                 }
             }
         },
+        "scale.ScaleRequest": {
+            "type": "object",
+            "properties": {
+                "replicas": {
+                    "description": "Replicas 期望的副本数.",
+                    "type": "integer"
+                }
+            }
+        },
         "secret.CreateSecretRequest": {
             "type": "object",
             "properties": {
@@ -1610,6 +5465,104 @@ var doc = `{
                 }
             }
         },
+        "service.ServiceRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "Name Service 对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间",
+                    "type": "string"
+                },
+                "service": {
+                    "description": "Service 对象的类型、选择器和端口.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.ServiceArgs"
+                }
+            }
+        },
+        "statefulset.CreateStatefulSetRequest": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "description": "StatefulSet 对象名称，同时也是 Headless Service 对象的名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                },
+                "statefulSet": {
+                    "description": "StatefulSet statefulset对象参数.",
+                    "type": "object",
+                    "$ref": "#/definitions/model.StatefulSetArgs"
+                }
+            }
+        },
+        "statefulset.DeleteStatefulSetRequest": {
+            "type": "object",
+            "properties": {
+                "deletePersistentVolumeClaims": {
+                    "description": "DeletePersistentVolumeClaims 是否同时删除由 VolumeClaimTemplates 创建的 PersistentVolumeClaim 对象.",
+                    "type": "boolean"
+                },
+                "name": {
+                    "description": "Name StatefulSet对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "statefulset.UpdateStatefulSetRequest": {
+            "type": "object",
+            "properties": {
+                "container": {
+                    "description": "Container whose image is replaced, the image is not changed if it is empty.",
+                    "type": "string"
+                },
+                "image": {
+                    "description": "New image of the container.",
+                    "type": "string"
+                },
+                "name": {
+                    "description": "Name StatefulSet对象名称.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                },
+                "partition": {
+                    "description": "Pods with an ordinal greater than or equal to the partition are updated, the others keep\nthe current revision. Nil keeps the partition of the stateful set.",
+                    "type": "integer"
+                }
+            }
+        },
+        "terminal.ShellResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "ID 终端会话 ID，用于连接终端.",
+                    "type": "string"
+                }
+            }
+        },
+        "terminal.TerminalMessage": {
+            "type": "object",
+            "properties": {
+                "op": {
+                    "type": "string"
+                },
+                "rows": {
+                    "type": "integer"
+                }
+            }
+        },
         "tool.Response": {
             "type": "object",
             "properties": {
@@ -1625,6 +5578,21 @@ var doc = `{
             }
         },
         "user.CreateRequest": {
+            "type": "object",
+            "properties": {
+                "admin": {
+                    "description": "Admin 是否为管理员.",
+                    "type": "boolean"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "user.LoginRequest": {
             "type": "object",
             "properties": {
                 "password": {
@@ -1634,6 +5602,28 @@ var doc = `{
                     "type": "string"
                 }
             }
+        },
+        "user.NamespaceRequest": {
+            "type": "object",
+            "properties": {
+                "cluster": {
+                    "description": "Cluster 集群名称，为空时使用默认集群.",
+                    "type": "string"
+                },
+                "namespace": {
+                    "description": "Namespace 命名空间.",
+                    "type": "string"
+                }
+            }
+        },
+        "user.UpdateRequest": {
+            "type": "object",
+            "properties": {
+                "password": {
+                    "description": "Password 新密码.",
+                    "type": "string"
+                }
+            }
         }
     }
 }`
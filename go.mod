@@ -38,4 +38,5 @@ require (
 	k8s.io/apimachinery v0.18.2
 	k8s.io/client-go v0.18.2
 	k8s.io/heapster v1.5.4
+	sigs.k8s.io/yaml v1.2.0
 )
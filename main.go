@@ -3,7 +3,13 @@ package main
 import (
 	"errors"
 	"hello-k8s/pkg/config"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/model"
+	"hello-k8s/pkg/model/cluster"
+	"hello-k8s/pkg/model/user"
 	"hello-k8s/pkg/router"
+	"hello-k8s/pkg/router/middleware"
+	"hello-k8s/pkg/utils/token"
 	"net/http"
 	"time"
 
@@ -26,8 +32,28 @@ func main() {
 	}
 
 	// init db
-	// model.DB.Init()
-	// defer model.DB.Close()
+	if err := model.DB.Init(); err != nil {
+		panic(err)
+	}
+	defer model.DB.Close()
+	if err := model.DB.Migrate(); err != nil {
+		panic(err)
+	}
+
+	// init token manager
+	if err := token.Init(); err != nil {
+		panic(err)
+	}
+
+	// init kubernetes clients
+	registry, err := client.NewRegistryFromConfig()
+	if err != nil {
+		panic(err)
+	}
+	if model.DB.Ready() {
+		bootstrapAdmin()
+		loadClusters(registry)
+	}
 
 	// Set gin mode.
 	gin.SetMode(viper.GetString("runmode"))
@@ -36,7 +62,9 @@ func main() {
 	g := gin.New()
 
 	// gin middlewares
-	middlewares := []gin.HandlerFunc{}
+	middlewares := []gin.HandlerFunc{
+		middleware.ClusterRegistry(registry),
+	}
 
 	// Routes.
 	router.Load(
@@ -75,3 +103,59 @@ func pingServer() error {
 	}
 	return errors.New("Cannot connect to the router.")
 }
+
+// bootstrapAdmin creates the administrator configured by auth.admin.username
+// and auth.admin.password when there is no administrator in the database.
+// Users can only be created by administrators, so the first one is created
+// here.
+func bootstrapAdmin() {
+	username := viper.GetString("auth.admin.username")
+	password := viper.GetString("auth.admin.password")
+	if username == "" || password == "" {
+		return
+	}
+
+	ok, err := user.BootstrapAdmin(username, password)
+	if err != nil {
+		log.Errorf(err, "Failed to bootstrap the administrator %q.", username)
+		return
+	}
+	if ok {
+		log.Infof("The administrator %q has been bootstrapped.", username)
+	}
+}
+
+// loadClusters registers the clusters that were added through the API.
+func loadClusters(registry *client.Registry) {
+	clusters, err := cluster.ListCluster()
+	if err != nil {
+		log.Errorf(err, "Failed to load clusters from the database.")
+		return
+	}
+
+	for _, c := range clusters {
+		if c.KubeConfig != "" {
+			if err := client.ValidateKubeConfig(c.KubeConfig); err != nil {
+				log.Errorf(err, "Skipping cluster %q", c.Name)
+				continue
+			}
+		}
+
+		m, err := client.NewManager(client.ClusterConfig{
+			Name:          c.Name,
+			KubeConfig:    c.KubeConfig,
+			Context:       c.Context,
+			APIServerHost: c.APIServerHost,
+			QPS:           c.QPS,
+			Burst:         c.Burst,
+		})
+		if err != nil {
+			log.Errorf(err, "Skipping cluster %q", c.Name)
+			continue
+		}
+
+		if err := registry.Add(c.Name, m); err != nil {
+			log.Errorf(err, "Skipping cluster %q", c.Name)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package cluster
+
+// CreateRequest 定义了添加一个 Kubernetes 集群时所需参数.
+type CreateRequest struct {
+	// Name 集群名称.
+	Name string `json:"name"`
+
+	// KubeConfig kubeconfig 文件内容.
+	KubeConfig string `json:"kubeconfig"`
+
+	// Context 使用的 kubeconfig context，为空时使用 current-context.
+	Context string `json:"context"`
+
+	// APIServerHost apiserver 地址，格式为 'protocol://address:port'.
+	APIServerHost string `json:"apiserverHost"`
+
+	// QPS 客户端限流参数.
+	QPS float32 `json:"qps"`
+
+	// Burst 客户端限流参数.
+	Burst int `json:"burst"`
+}
+
+// ClusterInfo 定义了一个 Kubernetes 集群的概要信息.
+type ClusterInfo struct {
+	// Name 集群名称.
+	Name string `json:"name"`
+
+	// Host apiserver 地址.
+	Host string `json:"host"`
+
+	// Default 是否为默认集群.
+	Default bool `json:"default"`
+}
+
+// ListResponse 定义了集群列表的返回结果.
+type ListResponse struct {
+	TotalCount  int           `json:"totalCount"`
+	ClusterList []ClusterInfo `json:"clusterList"`
+}
+
+// TestResponse 定义了集群连通性测试的返回结果.
+type TestResponse struct {
+	// Name 集群名称.
+	Name string `json:"name"`
+
+	// GitVersion 集群的 Kubernetes 版本.
+	GitVersion string `json:"gitVersion"`
+
+	// Platform 集群的平台信息.
+	Platform string `json:"platform"`
+}
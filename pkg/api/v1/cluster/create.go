@@ -0,0 +1,112 @@
+package cluster
+
+import (
+	"errors"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/model"
+	mcluster "hello-k8s/pkg/model/cluster"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/spf13/viper"
+)
+
+// @Summary 添加 Kubernetes 集群
+// @Description 添加 Kubernetes 集群，添加前会测试集群的连通性. kubeconfig 中的证书和凭证必须以内联数据的形式提供，
+// @Description 不支持 exec、auth-provider 插件以及 client-certificate、token-file 等文件路径. kubeconfig 加密后保存到数据库.
+// @Tags cluster
+// @Accept json
+// @Produce json
+// @param data body cluster.CreateRequest true "添加集群时所需参数"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/cluster [post]
+func Create(c *gin.Context) {
+	log.Debug("调用添加集群的函数.")
+
+	var r CreateRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || (r.KubeConfig == "" && r.APIServerHost == "") {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if r.KubeConfig != "" {
+		if err := client.ValidateKubeConfig(r.KubeConfig); err != nil {
+			tool.SendResponse(c, errno.ErrBadK8sConfig, err.Error())
+			return
+		}
+	}
+
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.InternalServerError, nil)
+		return
+	}
+
+	// The kubeconfig holds the credentials of the cluster, it is only stored encrypted.
+	if model.DB.Ready() && r.KubeConfig != "" && viper.GetString("cluster.encryption_key") == "" {
+		tool.SendResponse(c, errno.ErrAddCluster, "cluster.encryption_key is not configured")
+		return
+	}
+
+	if _, err := registry.Get(r.Name); err == nil {
+		tool.SendResponse(c, errno.ErrClusterExists, nil)
+		return
+	}
+
+	m, err := client.NewManager(client.ClusterConfig{
+		Name:          r.Name,
+		KubeConfig:    r.KubeConfig,
+		Context:       r.Context,
+		APIServerHost: r.APIServerHost,
+		QPS:           r.QPS,
+		Burst:         r.Burst,
+	})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBadK8sConfig, err.Error())
+		return
+	}
+
+	if _, err := m.Client().Discovery().ServerVersion(); err != nil {
+		tool.SendResponse(c, errno.ErrConnectCluster, err.Error())
+		return
+	}
+
+	if err := registry.Add(r.Name, m); err != nil {
+		if errors.Is(err, client.ErrClusterExists) {
+			tool.SendResponse(c, errno.ErrClusterExists, nil)
+			return
+		}
+		tool.SendResponse(c, errno.ErrAddCluster, err.Error())
+		return
+	}
+
+	// Persist the cluster so that it is restored on restart.
+	if model.DB.Ready() {
+		cm := mcluster.ClusterModel{
+			Name:          r.Name,
+			KubeConfig:    r.KubeConfig,
+			Context:       r.Context,
+			APIServerHost: r.APIServerHost,
+			QPS:           r.QPS,
+			Burst:         r.Burst,
+		}
+		if err := cm.Create(); err != nil {
+			log.Errorf(err, "保存集群 %s 失败", r.Name)
+			registry.Remove(r.Name)
+			tool.SendResponse(c, errno.ErrDatabase, nil)
+			return
+		}
+	}
+
+	tool.SendResponse(c, errno.OK, ClusterInfo{
+		Name: r.Name,
+		Host: m.Config().Host,
+	})
+}
@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/model"
+	mcluster "hello-k8s/pkg/model/cluster"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 移除 Kubernetes 集群
+// @Description 移除 Kubernetes 集群，默认集群不可移除
+// @Tags cluster
+// @Produce json
+// @Param name path string true "集群名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/cluster/{name} [delete]
+func Delete(c *gin.Context) {
+	log.Debug("调用移除集群的函数.")
+
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.InternalServerError, nil)
+		return
+	}
+
+	if _, err := registry.Get(name); err != nil {
+		tool.SendResponse(c, errno.ErrClusterNotFound, nil)
+		return
+	}
+
+	if err := registry.Remove(name); err != nil {
+		tool.SendResponse(c, errno.ErrRemoveCluster, err.Error())
+		return
+	}
+
+	if model.DB.Ready() {
+		if err := mcluster.DeleteCluster(name); err != nil {
+			tool.SendResponse(c, errno.ErrDatabase, nil)
+			return
+		}
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
@@ -0,0 +1,45 @@
+package cluster
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取所有已注册的 Kubernetes 集群
+// @Description 获取所有已注册的 Kubernetes 集群
+// @Tags cluster
+// @Produce json
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/cluster [get]
+func List(c *gin.Context) {
+	log.Debug("调用获取集群列表的函数.")
+
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.InternalServerError, nil)
+		return
+	}
+
+	rsp := ListResponse{
+		ClusterList: make([]ClusterInfo, 0),
+	}
+	for _, name := range registry.Names() {
+		m, err := registry.Get(name)
+		if err != nil {
+			continue
+		}
+
+		rsp.ClusterList = append(rsp.ClusterList, ClusterInfo{
+			Name:    name,
+			Host:    m.Config().Host,
+			Default: name == registry.Default(),
+		})
+	}
+	rsp.TotalCount = len(rsp.ClusterList)
+
+	tool.SendResponse(c, errno.OK, rsp)
+}
@@ -0,0 +1,51 @@
+package cluster
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 测试 Kubernetes 集群的连通性
+// @Description 测试 Kubernetes 集群的连通性并返回集群版本
+// @Tags cluster
+// @Produce json
+// @Param name path string true "集群名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/cluster/{name}/test [get]
+func Test(c *gin.Context) {
+	log.Debug("调用测试集群连通性的函数.")
+
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.InternalServerError, nil)
+		return
+	}
+
+	m, err := registry.Get(name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrClusterNotFound, nil)
+		return
+	}
+
+	version, err := m.Client().Discovery().ServerVersion()
+	if err != nil {
+		tool.SendResponse(c, errno.ErrConnectCluster, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, TestResponse{
+		Name:       name,
+		GitVersion: version.GitVersion,
+		Platform:   version.Platform,
+	})
+}
@@ -0,0 +1,185 @@
+package apply
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// 清单文件的最大长度.
+const maxManifestBytes = 2 << 20
+
+// ApplyRequest 定义了应用资源清单时所需参数.
+type ApplyRequest struct {
+	// Content YAML 或 JSON 格式的资源清单，多个对象之间用 --- 分隔. 以 multipart/form-data 上传 file 时可以为空.
+	Content string `json:"content" form:"content"`
+
+	// Namespace 覆盖清单中所有命名空间级别对象的命名空间，为空时使用对象自身的命名空间，默认为 default.
+	Namespace string `json:"namespace" form:"namespace"`
+
+	// DryRun 为 true 时由 apiserver 校验请求但不保存对象.
+	DryRun bool `json:"dryRun" form:"dryRun"`
+
+	// ValidateOnly 为 true 时只解析清单并检查资源类型，不访问 apiserver 的资源接口.
+	ValidateOnly bool `json:"validateOnly" form:"validateOnly"`
+}
+
+// @Summary 根据 YAML 或 JSON 资源清单创建或更新对象
+// @Description 解析包含多个对象的资源清单，不存在的对象会被创建，已存在的对象会被替换，返回每个对象的处理结果.
+// @Description 清单可以放在 JSON 请求体的 content 字段中，也可以通过 multipart/form-data 的 file 字段上传.
+// @Description 普通用户只能将对象应用到自己拥有的命名空间，且不能创建集群级别的对象.
+// @Tags resource
+// @Accept json,mpfd
+// @Produce json
+// @param data body apply.ApplyRequest false "资源清单和应用参数"
+// @Param file formData file false "资源清单文件"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":[{"apiVersion":"v1","kind":"ConfigMap","name":"","namespace":"","action":"created"}]}"
+// @Router /resource/apply [post]
+func Apply(c *gin.Context) {
+	log.Info("调用应用资源清单的函数")
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxManifestBytes)
+
+	var r ApplyRequest
+	if err := c.ShouldBind(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Content == "" {
+		content, err := formFile(c)
+		if err != nil {
+			tool.SendResponse(c, errno.ErrBind, err.Error())
+			return
+		}
+		r.Content = content
+	}
+
+	if r.Content == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes clients
+	m, err := client.ManagerFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	discoveryClient := m.DiscoveryClient()
+	objects, err := deployment.ParseManifest(discoveryClient, r.Content, r.Namespace)
+	if err != nil {
+		// The kinds may be served after the discovery cache was filled, e.g. new CRDs.
+		discoveryClient.Invalidate()
+		objects, err = deployment.ParseManifest(discoveryClient, r.Content, r.Namespace)
+	}
+	if err != nil {
+		tool.SendResponse(c, errno.ErrInvalidManifest, err.Error())
+		return
+	}
+
+	if err := checkAccess(c, objects); err != nil {
+		tool.SendResponse(c, err, nil)
+		return
+	}
+
+	if r.ValidateOnly {
+		results := make([]deployment.ApplyResult, 0, len(objects))
+		for _, object := range objects {
+			result := deployment.NewApplyResult(object)
+			result.Action = deployment.ApplyActionValid
+			results = append(results, result)
+		}
+
+		tool.SendResponse(c, errno.OK, results)
+		return
+	}
+
+	results := deployment.ApplyObjects(m.DynamicClient(), objects, r.DryRun)
+	for _, result := range results {
+		if result.Action == deployment.ApplyActionFailed {
+			tool.SendResponse(c, errno.ErrApplyManifest, results)
+			return
+		}
+	}
+
+	tool.SendResponse(c, errno.OK, results)
+}
+
+// Reads the uploaded manifest file, returns an empty string if the request
+// is not a multipart upload.
+func formFile(c *gin.Context) (string, error) {
+	if c.ContentType() != gin.MIMEMultipartPOSTForm {
+		return "", nil
+	}
+
+	header, err := c.FormFile("file")
+	if err == http.ErrMissingFile {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	file, err := header.Open()
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		return "", err
+	}
+
+	return string(content), nil
+}
+
+// Makes sure the current user can apply the objects. The apply route is not
+// guarded by the namespace middleware because the request can be a multipart
+// form, so the namespaces of the objects are checked here. Only administrators can apply
+// cluster scoped objects.
+func checkAccess(c *gin.Context, objects []deployment.ManifestObject) error {
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		return errno.ErrTokenInvalid
+	}
+
+	if muser.IsAdmin(ctx.Username) {
+		return nil
+	}
+
+	cluster := c.GetString(client.ClusterContextKey)
+	checked := make(map[string]bool)
+	for _, object := range objects {
+		if !object.Namespaced {
+			return errno.ErrPermissionDenied
+		}
+
+		namespace := object.Object.GetNamespace()
+		if checked[namespace] {
+			continue
+		}
+
+		ok, err := muser.OwnNamespace(ctx.Username, cluster, namespace)
+		if err != nil {
+			log.Errorf(err, "Failed to check the owner of namespace %q", namespace)
+			return errno.ErrDatabase
+		}
+		if !ok {
+			return errno.ErrPermissionDenied
+		}
+		checked[namespace] = true
+	}
+
+	return nil
+}
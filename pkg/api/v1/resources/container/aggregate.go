@@ -0,0 +1,158 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"hello-k8s/pkg/utils/wsutil"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/lexkong/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+)
+
+// 聚合日志时每个容器默认推送的日志行数.
+const defaultAggregatedTailLines int64 = 10
+
+// @Summary 实时获取某一工作负载所有 Pod 的 Logs.
+// @Description 将 get 请求升级为 WebSocket 协议，同时跟踪 Deployment、Job 或 Service 对象所有 Pod 中所有容器的日志，日志按时间戳合并后推送. 滚动更新过程中新建和删除的 Pod 会被自动跟踪和移除.
+// @Tags resource
+// @Param kind path string true "资源类型，可选值为 deployment、job 和 service"
+// @Param name path string true "对象名称"
+// @Param namespace path string true "命名空间"
+// @Param tailLines query int false "每个已运行的容器从最后多少行日志开始推送，默认为 10"
+// @Success 200 {object} container.AggregatedLogLine "{"pod":"","container":"","timestamp":"","content":""}"
+// @Router /resource/logs/{kind}/{name}/{namespace} [get]
+func GetAggregatedLogs(c *gin.Context) {
+	log.Debug("获取某一工作负载所有 Pod 的 Logs.")
+
+	kind := c.Param("kind")
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if kind == "" || name == "" || namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	tailLines := defaultAggregatedTailLines
+	if s := c.Query("tailLines"); s != "" {
+		var err error
+		if tailLines, err = strconv.ParseInt(s, 10, 64); err != nil || tailLines < 0 {
+			tool.SendResponse(c, errno.ErrBadParam, nil)
+			return
+		}
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	selector, err := workloadSelector(clientset, kind, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetPodLogs, err.Error())
+		return
+	}
+
+	// 升级 get 请求为 webSocket 协议
+	ws, err := wsutil.Upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Errorf(err, "升级 get 请求为 webSocket 协议失败")
+		return
+	}
+	defer ws.Close()
+
+	t := newTailer(clientset, namespace, selector, tailLines)
+	go t.Run()
+	defer t.Stop()
+
+	streamAggregatedLogs(ws, t.Lines())
+}
+
+// Returns the pod selector of the workload.
+func workloadSelector(clientset kubernetes.Interface, kind, namespace, name string) (labels.Selector, error) {
+	switch kind {
+	case "deployment":
+		deployment, err := clientset.AppsV1().Deployments(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metav1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	case "job":
+		job, err := clientset.BatchV1().Jobs(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	case "service":
+		service, err := clientset.CoreV1().Services(namespace).Get(context.TODO(), name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		if len(service.Spec.Selector) == 0 {
+			return nil, fmt.Errorf("service %s has no selector", name)
+		}
+		return labels.SelectorFromSet(service.Spec.Selector), nil
+	default:
+		return nil, fmt.Errorf("unsupported kind %s", kind)
+	}
+}
+
+// Pushes the lines to the peer sorted by timestamp in batches until the peer
+// goes away.
+func streamAggregatedLogs(ws *websocket.Conn, lines <-chan AggregatedLogLine) {
+	closed := wsutil.ReadPeer(ws)
+
+	pingTicker := time.NewTicker(wsutil.PingPeriod)
+	defer pingTicker.Stop()
+	mergeTicker := time.NewTicker(mergeInterval)
+	defer mergeTicker.Stop()
+
+	buffer := make([]AggregatedLogLine, 0, maxMergedLines)
+	flush := func() error {
+		if len(buffer) == 0 {
+			return nil
+		}
+
+		sortLines(buffer)
+		for _, line := range buffer {
+			ws.SetWriteDeadline(time.Now().Add(wsutil.WriteWait))
+			if err := ws.WriteJSON(line); err != nil {
+				return err
+			}
+		}
+		buffer = buffer[:0]
+		return nil
+	}
+
+	for {
+		select {
+		case <-closed:
+			return
+		case line := <-lines:
+			buffer = append(buffer, line)
+			if len(buffer) >= maxMergedLines {
+				if err := flush(); err != nil {
+					return
+				}
+			}
+		case <-mergeTicker.C:
+			if err := flush(); err != nil {
+				return
+			}
+		case <-pingTicker.C:
+			if err := wsutil.Ping(ws); err != nil {
+				return
+			}
+		}
+	}
+}
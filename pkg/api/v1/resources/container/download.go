@@ -0,0 +1,97 @@
+package container
+
+import (
+	"compress/gzip"
+	"fmt"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/container"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 下载某一 Container 对象的完整日志.
+// @Description 以附件的形式下载某一 Container 对象的完整日志，日志直接从 apiserver 流式转发，不会整体加载到内存中.
+// @Tags resource
+// @Produce plain
+// @Param namespace path string true "命名空间"
+// @Param podId path string true "PodID"
+// @Param container path string true "Container"
+// @Param previous query bool false "是否下载上一次运行的容器的日志"
+// @Param compress query bool false "是否使用 gzip 压缩"
+// @Success 200 {string} string "日志文件"
+// @Router /resource/container/download/{namespace}/{podId}/{container} [get]
+func DownloadLogs(c *gin.Context) {
+	log.Debug("下载某一 Container 对象的 Logs.")
+
+	namespace := c.Param("namespace")
+	podID := c.Param("podId")
+	containerName := c.Param("container")
+	if namespace == "" || podID == "" || containerName == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	previous, err := queryBool(c, "previous")
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+	compress, err := queryBool(c, "compress")
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	logFile, err := container.GetLogFile(clientset, namespace, podID, containerName, previous)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetPodLogs, err.Error())
+		return
+	}
+	defer logFile.Close()
+
+	filename := logFilename(podID, containerName, previous)
+	contentType := "text/plain; charset=utf-8"
+	if compress {
+		filename += ".gz"
+		contentType = "application/gzip"
+	}
+
+	c.Header("Content-Type", contentType)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Status(http.StatusOK)
+
+	var w io.Writer = c.Writer
+	if compress {
+		gw := gzip.NewWriter(c.Writer)
+		defer gw.Close()
+		w = gw
+	}
+
+	// The response has been started, errors can only be logged.
+	if _, err := io.Copy(w, logFile); err != nil {
+		log.Errorf(err, "下载pod[%s:%s:%s]的日志失败!", namespace, podID, containerName)
+	}
+}
+
+// Returns the name of the downloaded log file, e.g. nginx-7c6f-web-20200101-150405.log.
+func logFilename(podID, containerName string, previous bool) string {
+	name := podID + "-" + containerName
+	if previous {
+		name += "-previous"
+	}
+
+	return name + "-" + time.Now().Format("20060102-150405") + ".log"
+}
@@ -28,8 +28,8 @@ func GetPodContainers(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
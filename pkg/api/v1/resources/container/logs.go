@@ -1,109 +1,118 @@
 package container
 
 import (
-	"encoding/json"
 	"hello-k8s/pkg/kubernetes/client"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/container"
-	"hello-k8s/pkg/kubernetes/kuberesource/resource/logs"
 	"hello-k8s/pkg/utils/errno"
 	"hello-k8s/pkg/utils/tool"
-	"net/http"
-	"strings"
+	"hello-k8s/pkg/utils/wsutil"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/lexkong/log"
+	v1 "k8s.io/api/core/v1"
 )
 
-var upGrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		return true
-	},
-}
-
-// @Summary 获取某一 Container 对象的 Logs.
-// @Description 获取某一 Container 对象的 Logs.
+// @Summary 实时获取某一 Container 对象的 Logs.
+// @Description 将 get 请求升级为 WebSocket 协议，持续推送 Container 对象新产生的日志，每条消息为一行日志.
 // @Tags resource
 // @Param namespace path string true "命名空间"
 // @Param podId path string true "PodID"
-// @Param containerId path string true "Container"
-// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
-// @Router /resource/container/logs/{namespace}/{podId}/{containerId} [get]
+// @Param container path string true "Container"
+// @Param tailLines query int false "从最后多少行日志开始推送，未指定 tailLines 和 sinceSeconds 时为 100"
+// @Param sinceSeconds query int false "从多少秒之前的日志开始推送"
+// @Param timestamps query bool false "是否返回日志的时间戳"
+// @Param previous query bool false "是否获取上一次运行的容器的日志"
+// @Success 200 {object} logs.LogLine "{"timestamp":"","content":""}"
+// @Router /resource/container/logs/{namespace}/{podId}/{container} [get]
 func GetLogs(c *gin.Context) {
 	log.Debug("获取某一 Container 对象的 Logs.")
 
 	namespace := c.Param("namespace")
 	podID := c.Param("podId")
-	containerID := c.Param("containerId")
-	if namespace == "" || podID == "" || containerID == "" {
+	containerName := c.Param("container")
+	if namespace == "" || podID == "" || containerName == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	logOptions, err := parseLogOptions(c, containerName)
+	if err != nil {
 		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
 	// 升级 get 请求为 webSocket 协议
-	ws, err := upGrader.Upgrade(c.Writer, c.Request, nil)
+	ws, err := wsutil.Upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		log.Errorf(err, "升级 get 请求为 webSocket 协议失败")
-		tool.SendResponse(c, errno.ErrUpGraderRequest, err)
 		return
 	}
-
 	defer ws.Close()
-	//读取ws中的数据
-	mt, _, err := ws.ReadMessage()
-	newestLogTimestamp := "newset"
-	for {
-		if strings.EqualFold(strings.ToLower(newestLogTimestamp), strings.ToLower("newset")) {
-			podLogs, err := container.GetLogDetails(clientset, namespace, podID,
-				containerID, logs.AllSelection, false)
-			if err != nil {
-				log.Errorf(err, "获取pod[%s:%s:%s]的日志失败!", namespace, podID, containerID)
-				break
-			}
-			newestLogTimestamp = string(podLogs.LogLines[len(podLogs.LogLines)-1].Timestamp)
-
-			data, err := json.Marshal(podLogs)
-
-			err = ws.WriteMessage(mt, data)
-
-		} else {
-			selection := &logs.Selection{
-				ReferencePoint: logs.LogLineId{
-					LogTimestamp: logs.LogTimestamp(newestLogTimestamp),
-					LineNum:      1,
-				},
-				OffsetFrom: 1,
-				OffsetTo:   2,
-			}
-
-			podLogs, err := container.GetLogDetails(clientset, namespace, podID,
-				containerID, selection, false)
-			if err != nil {
-				log.Errorf(err, "获取pod[%s:%s:%s]的日志失败!", namespace, podID, containerID)
-				break
-			}
-			if len(podLogs.LogLines) == 0 {
-				continue
-			}
-			tmpTimestamp := string(podLogs.LogLines[len(podLogs.LogLines)-1].Timestamp)
-
-			if newestLogTimestamp == tmpTimestamp {
-				continue
-			} else {
-				newestLogTimestamp = tmpTimestamp
-			}
-
-			data, err := json.Marshal(podLogs)
-
-			err = ws.WriteMessage(mt, data)
+
+	stream, err := container.FollowLogs(clientset, namespace, podID, logOptions)
+	if err != nil {
+		log.Errorf(err, "获取pod[%s:%s:%s]的日志失败!", namespace, podID, containerName)
+		wsutil.Close(ws, websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+	defer stream.Close()
+
+	streamLogs(ws, stream, logOptions.Timestamps)
+}
+
+// Parses the log options from the query parameters.
+func parseLogOptions(c *gin.Context, containerName string) (*v1.PodLogOptions, error) {
+	logOptions := &v1.PodLogOptions{
+		Container: containerName,
+	}
+
+	if s := c.Query("tailLines"); s != "" {
+		tailLines, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || tailLines < 0 {
+			return nil, errno.ErrBadParam
+		}
+		logOptions.TailLines = &tailLines
+	}
+
+	if s := c.Query("sinceSeconds"); s != "" {
+		sinceSeconds, err := strconv.ParseInt(s, 10, 64)
+		if err != nil || sinceSeconds <= 0 {
+			return nil, errno.ErrBadParam
 		}
+		logOptions.SinceSeconds = &sinceSeconds
+	}
 
-		// time.Sleep(time.Duration(time.Millisecond * 10))
+	// Do not send the whole log of a long running container by default.
+	if logOptions.TailLines == nil && logOptions.SinceSeconds == nil {
+		tailLines := defaultTailLines
+		logOptions.TailLines = &tailLines
 	}
+
+	var err error
+	if logOptions.Timestamps, err = queryBool(c, "timestamps"); err != nil {
+		return nil, err
+	}
+	if logOptions.Previous, err = queryBool(c, "previous"); err != nil {
+		return nil, err
+	}
+
+	return logOptions, nil
+}
+
+func queryBool(c *gin.Context, key string) (bool, error) {
+	s := c.Query(key)
+	if s == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(s)
 }
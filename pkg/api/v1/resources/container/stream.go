@@ -0,0 +1,100 @@
+package container
+
+import (
+	"bufio"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/logs"
+	"hello-k8s/pkg/utils/wsutil"
+	"io"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/lexkong/log"
+)
+
+const (
+	// 未指定 tailLines 和 sinceSeconds 时推送的日志行数.
+	defaultTailLines int64 = 100
+
+	// Longer log lines are split into several messages.
+	maxLineBytes = 64 * 1024
+	// Maximum number of log lines buffered between the log stream and the peer.
+	lineBufferSize = 64
+)
+
+// Reads the log stream line by line and pushes the lines to the peer until the
+// stream ends or the peer goes away. Pings are sent to keep the connection
+// alive and to detect dead peers.
+func streamLogs(ws *websocket.Conn, stream io.Reader, timestamps bool) {
+	closed := wsutil.ReadPeer(ws)
+	quit := make(chan struct{})
+	defer close(quit)
+
+	lines := make(chan string, lineBufferSize)
+	errc := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		errc <- readLines(stream, lines, quit)
+	}()
+
+	ticker := time.NewTicker(wsutil.PingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case line, ok := <-lines:
+			if !ok {
+				if err := <-errc; err != nil {
+					log.Errorf(err, "读取日志失败")
+					wsutil.Close(ws, websocket.CloseInternalServerErr, err.Error())
+					return
+				}
+				wsutil.Close(ws, websocket.CloseNormalClosure, "log stream ended")
+				return
+			}
+
+			ws.SetWriteDeadline(time.Now().Add(wsutil.WriteWait))
+			if err := ws.WriteJSON(toLogLine(line, timestamps)); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := wsutil.Ping(ws); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Reads the lines of the stream into the lines channel until the stream ends
+// or quit is closed.
+func readLines(stream io.Reader, lines chan<- string, quit <-chan struct{}) error {
+	reader := bufio.NewReaderSize(stream, maxLineBytes)
+	for {
+		line, _, err := reader.ReadLine()
+		if len(line) > 0 {
+			select {
+			case lines <- string(line):
+			case <-quit:
+				return nil
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func toLogLine(line string, timestamps bool) logs.LogLine {
+	if timestamps {
+		if lines := logs.ToLogLines(line); len(lines) == 1 {
+			return lines[0]
+		}
+	}
+
+	return logs.LogLine{Content: line}
+}
@@ -0,0 +1,281 @@
+package container
+
+import (
+	"bufio"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/container"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/logs"
+	"io"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lexkong/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+const (
+	// Maximum number of containers followed by one aggregated log session.
+	maxFollowedContainers = 100
+	// Lines of the containers are sorted by timestamp within this interval before they are sent.
+	mergeInterval = 500 * time.Millisecond
+	// Buffered lines are sent immediately when there are more lines than this.
+	maxMergedLines = 1000
+	// The pods are synced again after this delay when a log stream ends.
+	resyncDelay = time.Second
+)
+
+// AggregatedLogLine 定义了聚合日志中的一行日志.
+type AggregatedLogLine struct {
+	// Pod 名称.
+	Pod string `json:"pod"`
+
+	// Container 名称.
+	Container string `json:"container"`
+
+	// Timestamp 日志时间戳.
+	Timestamp logs.LogTimestamp `json:"timestamp"`
+
+	// Content 日志内容.
+	Content string `json:"content"`
+
+	time time.Time
+}
+
+// Follows the logs of all containers of the pods matching the selector. Pods
+// that are created or deleted while tailing, e.g. during a rollout, are
+// picked up or dropped automatically.
+type tailer struct {
+	client    kubernetes.Interface
+	namespace string
+	selector  labels.Selector
+	// Lines loaded from containers that were running before the tailer started.
+	tailLines int64
+	start     time.Time
+
+	lines chan AggregatedLogLine
+	quit  chan struct{}
+	// Pods of the informer, they are synced again when a stream ends.
+	pods cache.Store
+
+	lock    sync.Mutex
+	stopped bool
+	// Streams of the followed containers by container ID, a restarted
+	// container has a new ID and is followed by a new stream.
+	streams map[string]*containerStream
+	// Time of the last line read from the containers by container ID, the
+	// logs are followed from there when a stream of a running container ends.
+	lastLines map[string]lastLine
+}
+
+// The time of the last line read from a container of the pod.
+type lastLine struct {
+	pod  string
+	time time.Time
+}
+
+// The log stream of a followed container.
+type containerStream struct {
+	pod       string
+	container string
+	stream    io.ReadCloser
+}
+
+func newTailer(client kubernetes.Interface, namespace string, selector labels.Selector, tailLines int64) *tailer {
+	return &tailer{
+		client:    client,
+		namespace: namespace,
+		selector:  selector,
+		tailLines: tailLines,
+		start:     time.Now(),
+		lines:     make(chan AggregatedLogLine, lineBufferSize),
+		quit:      make(chan struct{}),
+		streams:   make(map[string]*containerStream),
+		lastLines: make(map[string]lastLine),
+	}
+}
+
+// Run watches the pods and follows their containers until Stop is called.
+func (t *tailer) Run() {
+	factory := informers.NewSharedInformerFactoryWithOptions(t.client, 0,
+		informers.WithNamespace(t.namespace),
+		informers.WithTweakListOptions(func(options *metav1.ListOptions) {
+			options.LabelSelector = t.selector.String()
+		}))
+
+	informer := factory.Core().V1().Pods().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			t.sync(obj.(*v1.Pod))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			t.sync(obj.(*v1.Pod))
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if pod, ok := obj.(*v1.Pod); ok {
+				t.remove(pod)
+			}
+		},
+	})
+	t.pods = informer.GetStore()
+
+	factory.Start(t.quit)
+	<-t.quit
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.stopped = true
+	for id, s := range t.streams {
+		s.stream.Close()
+		delete(t.streams, id)
+	}
+}
+
+// Stop stops watching the pods and closes all log streams.
+func (t *tailer) Stop() {
+	close(t.quit)
+}
+
+// Lines returns the channel of the log lines of all followed containers.
+func (t *tailer) Lines() <-chan AggregatedLogLine {
+	return t.lines
+}
+
+// Starts following the running containers of the pod that are not followed yet.
+func (t *tailer) sync(pod *v1.Pod) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.stopped {
+		return
+	}
+
+	for _, status := range pod.Status.ContainerStatuses {
+		id := status.ContainerID
+		if status.State.Running == nil || id == "" || t.streams[id] != nil {
+			continue
+		}
+
+		key := pod.Name + "/" + status.Name
+		if len(t.streams) >= maxFollowedContainers {
+			log.Warnf("Too many containers to follow, skipping %s", key)
+			continue
+		}
+
+		logOptions := &v1.PodLogOptions{
+			Container:  status.Name,
+			Timestamps: true,
+		}
+		if last, ok := t.lastLines[id]; ok {
+			// The stream of the container has ended while it is still running.
+			sinceTime := metav1.NewTime(last.time)
+			logOptions.SinceTime = &sinceTime
+		} else if status.State.Running.StartedAt.Time.Before(t.start) {
+			// Containers that were started before the tailer only show their recent lines.
+			tailLines := t.tailLines
+			logOptions.TailLines = &tailLines
+		}
+
+		stream, err := container.FollowLogs(t.client, pod.Namespace, pod.Name, logOptions)
+		if err != nil {
+			log.Errorf(err, "Failed to follow logs of %s", key)
+			continue
+		}
+
+		s := &containerStream{pod: pod.Name, container: status.Name, stream: stream}
+		t.streams[id] = s
+		go t.follow(id, s)
+	}
+}
+
+// Syncs all pods again, so that the containers skipped because of
+// maxFollowedContainers or restarted after their streams ended are followed.
+func (t *tailer) resync() {
+	for _, obj := range t.pods.List() {
+		if pod, ok := obj.(*v1.Pod); ok {
+			t.sync(pod)
+		}
+	}
+}
+
+// Stops following the containers of the deleted pod.
+func (t *tailer) remove(pod *v1.Pod) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	for id, s := range t.streams {
+		if s.pod == pod.Name {
+			s.stream.Close()
+			delete(t.streams, id)
+		}
+	}
+	for id, last := range t.lastLines {
+		if last.pod == pod.Name {
+			delete(t.lastLines, id)
+		}
+	}
+}
+
+// Reads the log stream of a container until it ends. The stream is removed
+// and the pods are synced again after resyncDelay, so a container that is
+// still running is followed again and a restarted one by its new ID.
+func (t *tailer) follow(id string, s *containerStream) {
+	defer func() {
+		s.stream.Close()
+		t.lock.Lock()
+		if t.streams[id] == s {
+			delete(t.streams, id)
+		}
+		t.lock.Unlock()
+
+		select {
+		case <-time.After(resyncDelay):
+			t.resync()
+		case <-t.quit:
+		}
+	}()
+
+	reader := bufio.NewReaderSize(s.stream, maxLineBytes)
+	for {
+		line, _, err := reader.ReadLine()
+		if len(line) > 0 {
+			l := toLogLine(string(line), true)
+			timestamp, _ := time.Parse(time.RFC3339Nano, string(l.Timestamp))
+			select {
+			case t.lines <- AggregatedLogLine{
+				Pod:       s.pod,
+				Container: s.container,
+				Timestamp: l.Timestamp,
+				Content:   l.Content,
+				time:      timestamp,
+			}:
+			case <-t.quit:
+				return
+			}
+
+			if !timestamp.IsZero() {
+				t.lock.Lock()
+				t.lastLines[id] = lastLine{pod: s.pod, time: timestamp}
+				t.lock.Unlock()
+			}
+		}
+
+		if err != nil {
+			return
+		}
+	}
+}
+
+// Sorts the lines by timestamp.
+func sortLines(lines []AggregatedLogLine) {
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].time.Before(lines[j].time)
+	})
+}
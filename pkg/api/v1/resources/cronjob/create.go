@@ -33,14 +33,17 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	tool.CreateNamespace(r.Namespace, clientset)
+	if err := tool.CreateNamespace(r.Namespace, clientset); err != nil {
+		tool.SendResponse(c, errno.ErrCreateNamespace, err.Error())
+		return
+	}
 
 	cronjob := newCronJob(r)
 	result, err := clientset.BatchV1beta1().CronJobs(r.Namespace).Create(context.TODO(), cronjob, metav1.CreateOptions{})
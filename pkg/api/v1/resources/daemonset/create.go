@@ -0,0 +1,119 @@
+package daemonset
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 创建DaemonSet对象
+// @Description 创建DaemonSet对象，在所有节点或者匹配 nodeSelector 的节点上各运行一个 Pod，通过 tolerations 可以调度到有污点的节点上. 命名空间必须已经存在.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body daemonset.CreateDaemonSetRequest true "创建DaemonSet对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/daemonset/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 DaemonSet 对象的函数")
+
+	var r CreateDaemonSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	strategy := r.DaemonSet.Strategy
+	if strategy.Type != "" && strategy.Type != apps.RollingUpdateDaemonSetStrategyType && strategy.Type != apps.OnDeleteDaemonSetStrategyType {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+	if strategy.Type == apps.OnDeleteDaemonSetStrategyType && strategy.MaxUnavailable != nil {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	exists, err := tool.NamespaceExists(r.Namespace, clientset)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNamespace, err.Error())
+		return
+	}
+	if !exists {
+		tool.SendResponse(c, errno.ErrNamespaceNotFound, nil)
+		return
+	}
+
+	daemonSet, err := clientset.AppsV1().DaemonSets(r.Namespace).Create(context.TODO(), newDaemonSet(r), metaV1.CreateOptions{})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateDaemonSet, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, daemonSet)
+}
+
+func newDaemonSet(r CreateDaemonSetRequest) *apps.DaemonSet {
+	selector, labels := tool.GetWorkloadLabels(r.Name, r.DaemonSet.Selector, r.DaemonSet.PodTemplate.Labels)
+
+	objectMeta := metaV1.ObjectMeta{
+		Name:   r.Name,
+		Labels: labels,
+	}
+
+	podSpec := tool.CreatePodSpec(r.Name, r.DaemonSet.PodTemplate)
+	if len(r.DaemonSet.NodeSelector) > 0 {
+		podSpec.NodeSelector = tool.GetLabelsMap(r.DaemonSet.NodeSelector)
+	}
+	podSpec.Tolerations = r.DaemonSet.Tolerations
+
+	daemonSet := apps.DaemonSet{
+		ObjectMeta: objectMeta,
+		Spec: apps.DaemonSetSpec{
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: selector,
+			},
+			Template: api.PodTemplateSpec{
+				ObjectMeta: objectMeta,
+				Spec:       *podSpec,
+			},
+		},
+	}
+
+	strategy := r.DaemonSet.Strategy
+	switch strategy.Type {
+	case apps.OnDeleteDaemonSetStrategyType:
+		daemonSet.Spec.UpdateStrategy = apps.DaemonSetUpdateStrategy{
+			Type: apps.OnDeleteDaemonSetStrategyType,
+		}
+	default:
+		daemonSet.Spec.UpdateStrategy = apps.DaemonSetUpdateStrategy{
+			Type: apps.RollingUpdateDaemonSetStrategyType,
+		}
+		if strategy.MaxUnavailable != nil {
+			daemonSet.Spec.UpdateStrategy.RollingUpdate = &apps.RollingUpdateDaemonSet{
+				MaxUnavailable: strategy.MaxUnavailable,
+			}
+		}
+	}
+
+	return &daemonSet
+}
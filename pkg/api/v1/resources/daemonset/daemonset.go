@@ -0,0 +1,38 @@
+package daemonset
+
+import (
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/daemonset"
+	"hello-k8s/pkg/model"
+)
+
+// CreateDaemonSetRequest 定义了创建一个DaemonSet对象时所需的参数
+type CreateDaemonSetRequest struct {
+	// DaemonSet 对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// DaemonSet daemonset对象参数.
+	DaemonSet model.DaemonSetArgs `json:"daemonSet"`
+}
+
+// UpdateDaemonSetRequest 定义了更新DaemonSet对象的镜像和更新策略时所需参数.
+type UpdateDaemonSetRequest struct {
+	// Name DaemonSet对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	daemonset.UpdateOptions
+}
+
+// DeleteDaemonSetRequest 定义了删除一个DaemonSet对象时所需参数.
+type DeleteDaemonSetRequest struct {
+	// Name DaemonSet对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+}
@@ -0,0 +1,53 @@
+package daemonset
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 删除指定DaemonSet对象.
+// @Description 删除指定DaemonSet对象及其在各个节点上的Pod.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body daemonset.DeleteDaemonSetRequest true "删除一个DaemonSet对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/daemonset/delete [delete]
+func Delete(c *gin.Context) {
+	log.Info("调用删除 DaemonSet 对象的函数.")
+
+	var r DeleteDaemonSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	deletePropagation := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{
+		PropagationPolicy: &deletePropagation,
+	}
+	if err := clientset.AppsV1().DaemonSets(r.Namespace).Delete(context.TODO(), r.Name, options); err != nil {
+		tool.SendResponse(c, errno.ErrDeleteDaemonSet, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
@@ -0,0 +1,46 @@
+package daemonset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/daemonset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary  查询某一 DaemonSet 对象的详情
+// @Description 查询某一 DaemonSet 对象的详情，包括节点选择器、容忍、更新策略和各节点上 Pod 的调度状态
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "DaemonSet 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/daemonset/detail/{name}/{namespace} [get]
+func GetDaemonSet(c *gin.Context) {
+	log.Info("调用查询 DaemonSet 对象详情的函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := daemonset.GetDaemonSetDetail(clientset, nil, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetDaemonSet, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
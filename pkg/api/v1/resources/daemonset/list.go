@@ -0,0 +1,50 @@
+package daemonset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/daemonset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取某一用户创建的所有 DaemonSet 对象
+// @Description 获取某一用户创建的所有 DaemonSet 对象
+// @Tags resource
+// @Param namespace path string true "用户的命名空间"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/daemonset/list/{namespace} [get]
+func GetDaemonSetList(c *gin.Context) {
+	log.Info("调用获取 DaemonSet 对象列表的函数")
+
+	namespace := c.Param("namespace")
+	if namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	namespaceQuery := common.NewNamespaceQuery([]string{namespace})
+
+	list, err := daemonset.GetDaemonSetList(clientset, namespaceQuery, dsQuery, nil)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetDaemonSetList, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
@@ -0,0 +1,52 @@
+package daemonset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/daemonset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询某一 DaemonSet 对象控制的Pods列表
+// @Description 查询某一 DaemonSet 对象控制的Pods列表
+// @Tags resource
+// @Accept json
+// @Produce json
+// @Param name path string true "DaemonSet 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/daemonset/pods/{name}/{namespace} [get]
+func GetDaemonSetPods(c *gin.Context) {
+	log.Info("调用获取 DaemonSet 对象的 Pods 列表函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+
+	podList, err := daemonset.GetDaemonSetPods(clientset, nil, dsQuery, name, namespace)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetDaemonSetPods, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, podList)
+}
@@ -0,0 +1,51 @@
+package daemonset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/daemonset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 更新DaemonSet对象的镜像和更新策略.
+// @Description 更新DaemonSet对象中指定容器的镜像，或者修改更新策略. RollingUpdate 策略下按 maxUnavailable 逐个节点滚动更新，OnDelete 策略下只有手动删除的 Pod 才会被更新.
+// @Description container 为空时不修改镜像，strategyType 为空时保持原有的更新策略.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body daemonset.UpdateDaemonSetRequest true "更新DaemonSet对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/daemonset/update [put]
+func Update(c *gin.Context) {
+	log.Info("调用更新 DaemonSet 对象的函数.")
+
+	var r UpdateDaemonSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || (r.Container != "" && r.Image == "") ||
+		(r.Container == "" && r.StrategyType == "" && r.MaxUnavailable == nil) {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := daemonset.UpdateDaemonSet(clientset, r.Namespace, r.Name, r.UpdateOptions)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateDaemonSet, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
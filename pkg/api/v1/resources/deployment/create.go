@@ -0,0 +1,174 @@
+package deployment
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 创建Deployment对象
+// @Description 创建Deployment对象，指定端口映射时同时创建同名的Service对象. 命名空间必须已经存在.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body deployment.CreateDeploymentRequest true "创建Deployment对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/deployment/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 Deployment 对象的函数")
+
+	var r CreateDeploymentRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	strategy := r.Deployment.Strategy.Type
+	if strategy != "" && strategy != apps.RecreateDeploymentStrategyType && strategy != apps.RollingUpdateDeploymentStrategyType {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if r.Deployment.Replicas != nil && *r.Deployment.Replicas < 0 {
+		tool.SendResponse(c, errno.ErrBadParam, "replicas must not be negative")
+		return
+	}
+
+	// Deployment 对象只支持 Always 重启策略.
+	if policy := r.Deployment.PodTemplate.RestartPolicy; policy != "" && policy != api.RestartPolicyAlways {
+		tool.SendResponse(c, errno.ErrBadParam, "restartPolicy must be Always")
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	exists, err := tool.NamespaceExists(r.Namespace, clientset)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNamespace, err.Error())
+		return
+	}
+	if !exists {
+		tool.SendResponse(c, errno.ErrNamespaceNotFound, nil)
+		return
+	}
+
+	deployment, err := clientset.AppsV1().Deployments(r.Namespace).Create(context.TODO(), newDeployment(r), metaV1.CreateOptions{})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateDeployment, err)
+		return
+	}
+
+	rsp := CreateDeploymentResponse{
+		Deployment: deployment,
+	}
+
+	if len(r.Deployment.PortMappings) > 0 {
+		service, err := clientset.CoreV1().Services(r.Namespace).Create(context.TODO(), newService(r), metaV1.CreateOptions{})
+		if err != nil {
+			// Roll back the deployment so that the request can be retried.
+			deletePropagation := metaV1.DeletePropagationBackground
+			options := metaV1.DeleteOptions{
+				PropagationPolicy: &deletePropagation,
+			}
+			if err := clientset.AppsV1().Deployments(r.Namespace).Delete(context.TODO(), r.Name, options); err != nil {
+				log.Errorf(err, "Failed to roll back deployment %s/%s", r.Namespace, r.Name)
+			}
+
+			tool.SendResponse(c, errno.ErrCreateService, err)
+			return
+		}
+		rsp.Service = service
+	}
+
+	tool.SendResponse(c, errno.OK, rsp)
+}
+
+func newDeployment(r CreateDeploymentRequest) *apps.Deployment {
+	selector, labels := tool.GetWorkloadLabels(r.Name, r.Deployment.Selector, r.Deployment.PodTemplate.Labels)
+
+	objectMeta := metaV1.ObjectMeta{
+		Name:   r.Name,
+		Labels: labels,
+	}
+
+	podSpec := tool.CreatePodSpec(r.Name, r.Deployment.PodTemplate)
+
+	replicas := int32(1)
+	if r.Deployment.Replicas != nil {
+		replicas = *r.Deployment.Replicas
+	}
+
+	deployment := apps.Deployment{
+		ObjectMeta: objectMeta,
+		Spec: apps.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: selector,
+			},
+			Template: api.PodTemplateSpec{
+				ObjectMeta: objectMeta,
+				Spec:       *podSpec,
+			},
+		},
+	}
+
+	strategy := r.Deployment.Strategy
+	switch strategy.Type {
+	case apps.RecreateDeploymentStrategyType:
+		deployment.Spec.Strategy = apps.DeploymentStrategy{
+			Type: apps.RecreateDeploymentStrategyType,
+		}
+	default:
+		deployment.Spec.Strategy = apps.DeploymentStrategy{
+			Type: apps.RollingUpdateDeploymentStrategyType,
+		}
+		if strategy.MaxSurge != nil || strategy.MaxUnavailable != nil {
+			deployment.Spec.Strategy.RollingUpdate = &apps.RollingUpdateDeployment{
+				MaxSurge:       strategy.MaxSurge,
+				MaxUnavailable: strategy.MaxUnavailable,
+			}
+		}
+	}
+
+	return &deployment
+}
+
+func newService(r CreateDeploymentRequest) *api.Service {
+	selector, _ := tool.GetWorkloadLabels(r.Name, r.Deployment.Selector, r.Deployment.PodTemplate.Labels)
+
+	service := &api.Service{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:   r.Name,
+			Labels: tool.GetLabelsMap(r.Deployment.PodTemplate.Labels),
+		},
+		Spec: api.ServiceSpec{
+			Selector: selector,
+			Type:     api.ServiceTypeClusterIP,
+		},
+	}
+
+	if r.Deployment.IsExternal {
+		service.Spec.Type = api.ServiceTypeLoadBalancer
+	}
+
+	service.Spec.Ports = tool.CreateServicePortsFromMappings(r.Deployment.PortMappings)
+
+	return service
+}
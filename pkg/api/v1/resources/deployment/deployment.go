@@ -1,7 +1,10 @@
 package deployment
 
 import (
+	"hello-k8s/pkg/model"
+
 	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
 )
 
 // CreateDeploymentRequest 定义了创建一个Deployment对象时所需的参数
@@ -12,8 +15,17 @@ type CreateDeploymentRequest struct {
 	// Namespace 命名空间.
 	Namespace string `json:"namespace"`
 
-	// Deployment deployment对象定义.
-	Deployment apps.Deployment `json:"deployment"`
+	// Deployment deployment对象参数.
+	Deployment model.DeploymentArgs `json:"deployment"`
+}
+
+// CreateDeploymentResponse 定义了创建Deployment对象的返回结果.
+type CreateDeploymentResponse struct {
+	// Deployment 创建的Deployment对象.
+	Deployment *apps.Deployment `json:"deployment"`
+
+	// Service 根据端口映射创建的Service对象，没有端口映射时为空.
+	Service *api.Service `json:"service,omitempty"`
 }
 
 // DeleteDeploymentRequest 定义了删除一个Deployment对象时所需参数.
@@ -24,3 +36,30 @@ type DeleteDeploymentRequest struct {
 	// Namespace 命名空间.
 	Namespace string `json:"namespace"`
 }
+
+// UpdateImageRequest 定义了更新Deployment对象容器镜像时所需参数.
+type UpdateImageRequest struct {
+	// Name Deployment对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// Container 容器名称.
+	Container string `json:"container"`
+
+	// Image 新的容器镜像.
+	Image string `json:"image"`
+}
+
+// RollbackRequest 定义了回滚Deployment对象时所需参数.
+type RollbackRequest struct {
+	// Name Deployment对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// Revision 回滚的目标版本，为 0 时回滚到低于当前版本的最高版本.
+	Revision int64 `json:"revision"`
+}
@@ -0,0 +1,157 @@
+package deployment
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 更新Deployment对象的容器镜像.
+// @Description 更新Deployment对象中指定容器的镜像并触发滚动更新.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body deployment.UpdateImageRequest true "更新容器镜像时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/deployment/image [put]
+func UpdateImage(c *gin.Context) {
+	log.Info("调用更新 Deployment 对象容器镜像的函数.")
+
+	var r UpdateImageRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || r.Container == "" || r.Image == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := deployment.UpdateContainerImage(clientset, r.Namespace, r.Name, r.Container, r.Image)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateDeploymentImage, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
+
+// @Summary 查询Deployment对象的滚动更新状态.
+// @Description 查询Deployment对象的滚动更新进度，包括新旧ReplicaSet和副本数.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "Deployment 对象名称"
+// @param namespace path string true "命名空间"
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/deployment/rollout/status/{name}/{namespace} [get]
+func GetRolloutStatus(c *gin.Context) {
+	log.Debug("调用查询 Deployment 对象滚动更新状态的函数.")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if name == "" || namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := deployment.GetRolloutStatus(clientset, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetRolloutStatus, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
+
+// @Summary 查询Deployment对象的历史版本.
+// @Description 查询Deployment对象的历史版本，按版本号从新到旧排序.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "Deployment 对象名称"
+// @param namespace path string true "命名空间"
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/deployment/rollout/history/{name}/{namespace} [get]
+func GetRolloutHistory(c *gin.Context) {
+	log.Debug("调用查询 Deployment 对象历史版本的函数.")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if name == "" || namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := deployment.GetRevisionHistory(clientset, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetRolloutHistory, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
+
+// @Summary 回滚Deployment对象.
+// @Description 将Deployment对象回滚到指定的历史版本.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body deployment.RollbackRequest true "回滚Deployment对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/deployment/rollout/undo [put]
+func Rollback(c *gin.Context) {
+	log.Info("调用回滚 Deployment 对象的函数.")
+
+	var r RollbackRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || r.Revision < 0 {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := deployment.Rollback(clientset, r.Namespace, r.Name, r.Revision)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrRollbackDeployment, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
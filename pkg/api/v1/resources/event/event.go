@@ -0,0 +1,57 @@
+package event
+
+import (
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/event"
+
+	"github.com/gin-gonic/gin"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// 可以查询事件的资源类型.
+var kinds = map[string]string{
+	"pod":                     "Pod",
+	"deployment":              "Deployment",
+	"replicaset":              "ReplicaSet",
+	"statefulset":             "StatefulSet",
+	"daemonset":               "DaemonSet",
+	"job":                     "Job",
+	"cronjob":                 "CronJob",
+	"service":                 "Service",
+	"ingress":                 "Ingress",
+	"persistentvolumeclaim":   "PersistentVolumeClaim",
+	"horizontalpodautoscaler": "HorizontalPodAutoscaler",
+}
+
+// EventMessage 定义了事件流推送的消息.
+type EventMessage struct {
+	// Type 事件的变化类型，ADDED 表示新事件，MODIFIED 表示事件再次发生.
+	Type watch.EventType `json:"type"`
+
+	// Event 事件.
+	Event common.Event `json:"event"`
+}
+
+// Returns the event type of the type query parameter, ok is false if it is invalid.
+func eventType(c *gin.Context) (string, bool) {
+	switch t := c.Query("type"); t {
+	case "", v1.EventTypeNormal, v1.EventTypeWarning:
+		return t, true
+	default:
+		return "", false
+	}
+}
+
+// Returns the event filter of the kind, name and type query parameters, ok is
+// false if any of them is invalid.
+func queryFilter(c *gin.Context) (filter event.EventFilter, ok bool) {
+	if kind := c.Query("kind"); kind != "" {
+		if filter.Kind, ok = kinds[kind]; !ok {
+			return filter, false
+		}
+	}
+	filter.Name = c.Query("name")
+	filter.Type, ok = eventType(c)
+	return filter, ok
+}
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/event"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取某一命名空间下的事件
+// @Description 获取某一命名空间下的事件，可按事件类型过滤
+// @Tags resource
+// @Param namespace path string true "命名空间"
+// @Param type query string false "事件类型，可选值为 Normal 和 Warning"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/event/list/{namespace} [get]
+func GetNamespaceEvents(c *gin.Context) {
+	log.Info("调用获取命名空间事件列表的函数")
+
+	namespace := c.Param("namespace")
+	eventType, ok := eventType(c)
+	if namespace == "" || !ok {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	list, err := event.GetFilteredEvents(clientset, dsQuery, namespace, event.EventFilter{Type: eventType})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetEvents, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
+
+// @Summary 获取某一对象的事件
+// @Description 获取某一对象的事件，可按事件类型过滤. 例如 Deployment 无法创建 Pod 时，其 ReplicaSet 和 Pod 的 Warning 事件说明了原因
+// @Tags resource
+// @Param kind path string true "资源类型，可选值为 pod、deployment、replicaset、statefulset、daemonset、job、cronjob、service、ingress、persistentvolumeclaim 和 horizontalpodautoscaler"
+// @Param name path string true "对象名称"
+// @Param namespace path string true "命名空间"
+// @Param type query string false "事件类型，可选值为 Normal 和 Warning"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/event/object/{kind}/{name}/{namespace} [get]
+func GetObjectEvents(c *gin.Context) {
+	log.Info("调用获取对象事件列表的函数")
+
+	kind, kindOK := kinds[c.Param("kind")]
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	eventType, typeOK := eventType(c)
+	if !kindOK || name == "" || namespace == "" || !typeOK {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	filter := event.EventFilter{Kind: kind, Name: name, Type: eventType}
+	list, err := event.GetFilteredEvents(clientset, dsQuery, namespace, filter)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetEvents, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
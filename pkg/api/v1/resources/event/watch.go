@@ -0,0 +1,108 @@
+package event
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/event"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"hello-k8s/pkg/utils/wsutil"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/lexkong/log"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// @Summary 实时获取某一命名空间下的事件.
+// @Description 将 get 请求升级为 WebSocket 协议，持续推送命名空间中新产生或再次发生的事件，可按对象和事件类型过滤.
+// @Tags resource
+// @Param namespace path string true "命名空间"
+// @Param kind query string false "资源类型，可选值与 /resource/event/object 相同"
+// @Param name query string false "对象名称"
+// @Param type query string false "事件类型，可选值为 Normal 和 Warning"
+// @Success 200 {object} event.EventMessage "{"type":"ADDED","event":{}}"
+// @Router /resource/event/watch/{namespace} [get]
+func WatchEvents(c *gin.Context) {
+	log.Debug("实时获取命名空间的事件.")
+
+	namespace := c.Param("namespace")
+	filter, ok := queryFilter(c)
+	if namespace == "" || !ok {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	w, err := event.WatchEvents(clientset, namespace, filter)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetEvents, err.Error())
+		return
+	}
+	defer w.Stop()
+
+	// 升级 get 请求为 webSocket 协议
+	ws, err := wsutil.Upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Errorf(err, "升级 get 请求为 webSocket 协议失败")
+		return
+	}
+	defer ws.Close()
+
+	streamEvents(ws, w)
+}
+
+// Pushes the added and modified events to the peer until the watch ends or
+// the peer goes away.
+func streamEvents(ws *websocket.Conn, w watch.Interface) {
+	closed := wsutil.ReadPeer(ws)
+
+	ticker := time.NewTicker(wsutil.PingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-closed:
+			return
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				wsutil.Close(ws, websocket.CloseNormalClosure, "event watch ended")
+				return
+			}
+
+			switch e.Type {
+			case watch.Added, watch.Modified:
+				item, ok := e.Object.(*v1.Event)
+				if !ok {
+					continue
+				}
+
+				message := EventMessage{
+					Type:  e.Type,
+					Event: event.ToEvent(event.FillEventsType([]v1.Event{*item})[0]),
+				}
+				ws.SetWriteDeadline(time.Now().Add(wsutil.WriteWait))
+				if err := ws.WriteJSON(message); err != nil {
+					return
+				}
+			case watch.Error:
+				err := apierrors.FromObject(e.Object)
+				log.Errorf(err, "监听事件失败")
+				wsutil.Close(ws, websocket.CloseInternalServerErr, err.Error())
+				return
+			}
+		case <-ticker.C:
+			if err := wsutil.Ping(ws); err != nil {
+				return
+			}
+		}
+	}
+}
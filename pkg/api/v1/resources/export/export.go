@@ -0,0 +1,70 @@
+package export
+
+import (
+	"bytes"
+	"fmt"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// 多文档 YAML 格式.
+	formatYAML = "yaml"
+	// 每个对象一个 YAML 文件的 tar.gz 压缩包.
+	formatTar = "tar"
+)
+
+// Returns the export format of the request, yaml by default.
+func formatOf(c *gin.Context) (string, bool) {
+	switch format := c.DefaultQuery("format", formatYAML); format {
+	case formatYAML, formatTar:
+		return format, true
+	default:
+		return "", false
+	}
+}
+
+// Returns the shared mapper and dynamic client of the cluster of the request.
+func clientsOf(c *gin.Context) (meta.RESTMapper, dynamic.Interface, error) {
+	m, err := client.ManagerFromContext(c)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return m.RESTMapper(), m.DynamicClient(), nil
+}
+
+// Sends the objects as an attachment. The name is the file name without the
+// extension, which depends on the format.
+func sendObjects(c *gin.Context, name, format string, objects []*unstructured.Unstructured) {
+	var content []byte
+	var err error
+	filename := name + ".yaml"
+	contentType := "application/yaml; charset=utf-8"
+
+	if format == formatTar {
+		var buf bytes.Buffer
+		err = deployment.WriteTarGz(&buf, objects)
+		content = buf.Bytes()
+		filename = name + ".tar.gz"
+		contentType = "application/gzip"
+	} else {
+		content, err = deployment.EncodeYAML(objects)
+	}
+
+	if err != nil {
+		tool.SendResponse(c, errno.ErrExportResource, err.Error())
+		return
+	}
+
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, contentType, content)
+}
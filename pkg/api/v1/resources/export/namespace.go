@@ -0,0 +1,45 @@
+package export
+
+import (
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 导出命名空间中所有对象的资源清单
+// @Description 导出命名空间中 ConfigMap、Secret、Service、PersistentVolumeClaim、Deployment、StatefulSet、DaemonSet、Job、CronJob、Ingress、HorizontalPodAutoscaler 等对象，
+// @Description 去掉由服务端填充的字段. 由控制器管理的对象（如 Deployment 创建的 Pod）和集群自动创建的对象不会被导出.
+// @Tags resource
+// @Accept json
+// @Produce application/yaml,application/gzip
+// @param namespace path string true "命名空间"
+// @param format query string false "导出格式，可选值为 yaml 和 tar，tar 为每个对象一个文件的 tar.gz 压缩包，默认为 yaml"
+// @Success 200 {string} string "资源清单文件"
+// @Router /resource/export/namespace/{namespace} [get]
+func ExportNamespace(c *gin.Context) {
+	log.Debug("调用导出命名空间的函数")
+
+	namespace := c.Param("namespace")
+	format, ok := formatOf(c)
+	if namespace == "" || !ok {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	mapper, dynamicClient, err := clientsOf(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	objects, err := deployment.ExportNamespace(mapper, dynamicClient, namespace)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrExportResource, err.Error())
+		return
+	}
+
+	sendObjects(c, namespace, format, objects)
+}
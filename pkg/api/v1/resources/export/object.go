@@ -0,0 +1,68 @@
+package export
+
+import (
+	"hello-k8s/pkg/api/v1/resources/raw"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// @Summary 导出单个对象的资源清单
+// @Description 导出对象的定义，去掉 status、managedFields、resourceVersion、uid 等由服务端填充的字段，导出的清单可以直接用于 /resource/apply.
+// @Description 资源类型可以是单数或复数形式，也可以带上 API 组，如 deployment、deployments.apps. 集群级别的对象使用 _cluster 作为命名空间，只有管理员可以导出.
+// @Tags resource
+// @Accept json
+// @Produce application/yaml,application/gzip
+// @param kind path string true "资源类型"
+// @param namespace path string true "命名空间，集群级别的对象为 _cluster"
+// @param name path string true "对象名称"
+// @param format query string false "导出格式，可选值为 yaml 和 tar，tar 为 tar.gz 压缩包，默认为 yaml"
+// @Success 200 {string} string "资源清单文件"
+// @Router /resource/export/object/{kind}/{namespace}/{name} [get]
+func ExportObject(c *gin.Context) {
+	log.Debug("调用导出单个对象的函数")
+
+	kind := c.Param("kind")
+	namespace := c.Param("namespace")
+	name := c.Param("name")
+	format, ok := formatOf(c)
+	if kind == "" || namespace == "" || name == "" || !ok {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if namespace == raw.ClusterScope {
+		ctx, err := token.FromContext(c)
+		if err != nil {
+			tool.SendResponse(c, errno.ErrTokenInvalid, nil)
+			return
+		}
+		if !muser.IsAdmin(ctx.Username) {
+			tool.SendResponse(c, errno.ErrPermissionDenied, nil)
+			return
+		}
+		namespace = ""
+	}
+
+	mapper, dynamicClient, err := clientsOf(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	obj, err := deployment.ExportObject(mapper, dynamicClient, kind, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrExportResource, err.Error())
+		return
+	}
+
+	filename := strings.TrimSuffix(deployment.ObjectFilename(obj), ".yaml")
+	sendObjects(c, filename, format, []*unstructured.Unstructured{obj})
+}
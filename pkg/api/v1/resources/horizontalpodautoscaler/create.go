@@ -0,0 +1,54 @@
+package horizontalpodautoscaler
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/horizontalpodautoscaler"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 创建 HorizontalPodAutoscaler 对象
+// @Description 为 Deployment 或 StatefulSet 对象创建 HorizontalPodAutoscaler，根据 CPU 和内存的平均使用率在 minReplicas 和 maxReplicas 之间自动伸缩. 使用率相对于容器的 requests 计算，所以伸缩对象的所有容器都必须设置了对应资源的 requests.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body horizontalpodautoscaler.HorizontalPodAutoscalerRequest true "创建 HorizontalPodAutoscaler 对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/horizontalpodautoscaler/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 HorizontalPodAutoscaler 对象的函数")
+
+	var r HorizontalPodAutoscalerRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || r.ScaleTargetRef.Name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := horizontalpodautoscaler.ValidateSpec(clientset, r.Namespace, r.Spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	result, err := horizontalpodautoscaler.CreateHorizontalPodAutoscaler(clientset, r.Namespace, r.Name, r.Spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateHorizontalPodAutoscaler, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
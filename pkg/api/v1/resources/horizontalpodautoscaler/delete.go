@@ -0,0 +1,49 @@
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 删除指定HorizontalPodAutoscaler对象.
+// @Description 删除指定HorizontalPodAutoscaler对象，伸缩对象保持当前的副本数.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body horizontalpodautoscaler.DeleteHorizontalPodAutoscalerRequest true "删除一个HorizontalPodAutoscaler对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/horizontalpodautoscaler/delete [delete]
+func Delete(c *gin.Context) {
+	log.Info("调用删除 HorizontalPodAutoscaler 对象的函数.")
+
+	var r DeleteHorizontalPodAutoscalerRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := clientset.AutoscalingV1().HorizontalPodAutoscalers(r.Namespace).Delete(context.TODO(), r.Name, metav1.DeleteOptions{}); err != nil {
+		tool.SendResponse(c, errno.ErrDeleteHorizontalPodAutoscaler, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
@@ -0,0 +1,64 @@
+package horizontalpodautoscaler
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/horizontalpodautoscaler"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary  查询某一 HorizontalPodAutoscaler 对象的详情
+// @Description 查询某一 HorizontalPodAutoscaler 对象的详情，包括当前和期望的副本数、各指标的当前值与目标值以及最近的伸缩事件
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "HorizontalPodAutoscaler 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Param itemsPerPage query int false "事件每页数量"
+// @Param page query int false "事件页码，从 1 开始"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/horizontalpodautoscaler/detail/{name}/{namespace} [get]
+func GetHorizontalPodAutoscaler(c *gin.Context) {
+	log.Info("调用查询 HorizontalPodAutoscaler 对象详情的函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	detail, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerDetail(clientset, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetHorizontalPodAutoscaler, err)
+		return
+	}
+
+	metrics, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerMetrics(clientset, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetHorizontalPodAutoscaler, err)
+		return
+	}
+
+	events, err := horizontalpodautoscaler.GetHorizontalPodAutoscalerEvents(clientset, tool.ParseDataSelectQuery(c), namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetHorizontalPodAutoscaler, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, HorizontalPodAutoscalerDetailResponse{
+		HorizontalPodAutoscalerDetail: detail,
+		Metrics:                       metrics,
+		Events:                        events,
+	})
+}
@@ -0,0 +1,38 @@
+package horizontalpodautoscaler
+
+import (
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/horizontalpodautoscaler"
+)
+
+// HorizontalPodAutoscalerRequest 定义了创建或更新一个HorizontalPodAutoscaler对象时所需的参数.
+type HorizontalPodAutoscalerRequest struct {
+	// Name HorizontalPodAutoscaler 对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// 伸缩的 Deployment 或 StatefulSet 对象、副本数范围以及 CPU、内存使用率目标.
+	horizontalpodautoscaler.Spec
+}
+
+// DeleteHorizontalPodAutoscalerRequest 定义了删除一个HorizontalPodAutoscaler对象时所需参数.
+type DeleteHorizontalPodAutoscalerRequest struct {
+	// Name HorizontalPodAutoscaler对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+}
+
+// HorizontalPodAutoscalerDetailResponse 定义了HorizontalPodAutoscaler对象详情的返回结果.
+type HorizontalPodAutoscalerDetailResponse struct {
+	*horizontalpodautoscaler.HorizontalPodAutoscalerDetail
+
+	// Metrics 各指标的当前值与目标值.
+	Metrics []horizontalpodautoscaler.MetricStatus `json:"metrics"`
+
+	// Events 最近的伸缩事件.
+	Events *common.EventList `json:"events"`
+}
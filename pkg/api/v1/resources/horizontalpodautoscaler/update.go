@@ -0,0 +1,54 @@
+package horizontalpodautoscaler
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/horizontalpodautoscaler"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 更新 HorizontalPodAutoscaler 对象
+// @Description 替换 HorizontalPodAutoscaler 对象的伸缩对象、副本数范围和使用率目标，校验规则与创建时相同，已配置的伸缩行为(behavior)保持不变.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body horizontalpodautoscaler.HorizontalPodAutoscalerRequest true "更新 HorizontalPodAutoscaler 对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/horizontalpodautoscaler/update [put]
+func Update(c *gin.Context) {
+	log.Info("调用更新 HorizontalPodAutoscaler 对象的函数")
+
+	var r HorizontalPodAutoscalerRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || r.ScaleTargetRef.Name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := horizontalpodautoscaler.ValidateSpec(clientset, r.Namespace, r.Spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	result, err := horizontalpodautoscaler.UpdateHorizontalPodAutoscaler(clientset, r.Namespace, r.Name, r.Spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateHorizontalPodAutoscaler, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -0,0 +1,54 @@
+package ingress
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/ingress"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 创建Ingress对象
+// @Description 创建Ingress对象，将域名和路径转发到指定 Service 的端口. 相同域名的规则会合并到一起，tls 中的 Secret 必须与 Ingress 在同一命名空间.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body ingress.IngressRequest true "创建Ingress对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/ingress/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 Ingress 对象的函数")
+
+	var r IngressRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if _, err := ingress.NewIngressSpec(r.Spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := ingress.CreateIngress(clientset, r.Namespace, r.Name, r.Spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateIngress, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -0,0 +1,49 @@
+package ingress
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 删除指定Ingress对象.
+// @Description 删除指定Ingress对象，后端的 Service 不会被删除.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body ingress.DeleteIngressRequest true "删除一个Ingress对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/ingress/delete [delete]
+func Delete(c *gin.Context) {
+	log.Info("调用删除 Ingress 对象的函数.")
+
+	var r DeleteIngressRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := clientset.ExtensionsV1beta1().Ingresses(r.Namespace).Delete(context.TODO(), r.Name, metav1.DeleteOptions{}); err != nil {
+		tool.SendResponse(c, errno.ErrDeleteIngress, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
@@ -0,0 +1,46 @@
+package ingress
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/ingress"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary  查询某一 Ingress 对象的详情
+// @Description 查询某一 Ingress 对象的详情，包括转发规则、TLS 配置和负载均衡地址
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "Ingress 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/ingress/detail/{name}/{namespace} [get]
+func GetIngress(c *gin.Context) {
+	log.Info("调用查询 Ingress 对象详情的函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := ingress.GetIngressDetail(clientset, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetIngress, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -0,0 +1,24 @@
+package ingress
+
+import "hello-k8s/pkg/kubernetes/kuberesource/resource/ingress"
+
+// IngressRequest 定义了创建或更新一个Ingress对象时所需的参数.
+type IngressRequest struct {
+	// Name Ingress 对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// Ingress 对象的转发规则、TLS 配置和 IngressClass.
+	ingress.Spec
+}
+
+// DeleteIngressRequest 定义了删除一个Ingress对象时所需参数.
+type DeleteIngressRequest struct {
+	// Name Ingress对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+}
@@ -0,0 +1,43 @@
+package ingress
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/ingress"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询某一命名空间的所有访问路由
+// @Description 列出命名空间中所有 Ingress 对象的域名、路径到 Service 端口的映射，并根据 Endpoints 给出后端就绪的地址数量.
+// @Description Service 或端口不存在时 error 字段给出原因，ready 为 true 表示后端至少有一个就绪的地址.
+// @Tags resource
+// @Param namespace path string true "用户的命名空间"
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/ingress/routes/{namespace} [get]
+func GetRoutes(c *gin.Context) {
+	log.Info("调用获取访问路由列表的函数")
+
+	namespace := c.Param("namespace")
+	if namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	routes, err := ingress.GetRouteList(clientset, namespace)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetIngressRoutes, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, routes)
+}
@@ -0,0 +1,54 @@
+package ingress
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/ingress"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 更新Ingress对象
+// @Description 使用请求中的转发规则、TLS 配置和 IngressClass 替换 Ingress 对象中原有的配置，默认后端和标签、注解保持不变.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body ingress.IngressRequest true "更新Ingress对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/ingress/update [put]
+func Update(c *gin.Context) {
+	log.Info("调用更新 Ingress 对象的函数.")
+
+	var r IngressRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if _, err := ingress.NewIngressSpec(r.Spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := ingress.UpdateIngress(clientset, r.Namespace, r.Name, r.Spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateIngress, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
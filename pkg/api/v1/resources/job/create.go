@@ -32,14 +32,17 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	tool.CreateNamespace(r.Namespace, clientset)
+	if err := tool.CreateNamespace(r.Namespace, clientset); err != nil {
+		tool.SendResponse(c, errno.ErrCreateNamespace, err.Error())
+		return
+	}
 
 	job := newJob(r)
 	result, err := clientset.BatchV1().Jobs(r.Namespace).Create(context.TODO(), job, metaV1.CreateOptions{})
@@ -3,7 +3,6 @@ package job
 import (
 	"hello-k8s/pkg/kubernetes/client"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
-	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/job"
 	"hello-k8s/pkg/utils/errno"
 
@@ -17,6 +16,10 @@ import (
 // @Description 获取某一用户创建的所有Job对象
 // @Tags resource
 // @Param namespace path string true "用户的命名空间"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
 // @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
 // @Router /resource/job/list/{namespace} [get]
 func GetJobList(c *gin.Context) {
@@ -28,14 +31,14 @@ func GetJobList(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter, dataselect.NoMetrics)
+	dsQuery := tool.ParseDataSelectQuery(c)
 	namespaceMap := make([]string, 0)
 	namespaceMap = append(namespaceMap, namespace)
 	namespaceQuery := common.NewNamespaceQuery(namespaceMap)
@@ -0,0 +1,103 @@
+package namespace
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	ns "hello-k8s/pkg/kubernetes/kuberesource/resource/namespace"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 创建命名空间
+// @Description 创建命名空间，并根据配置文件中的预设创建 ResourceQuota 和 LimitRange，可同时将命名空间分配给用户，仅管理员可调用
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body namespace.CreateNamespaceRequest true "创建命名空间时所需参数"
+// @Success 200 {object} namespace.CreateNamespaceResponse "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/namespace/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建命名空间的函数")
+
+	var r CreateNamespaceRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	var (
+		quota      *v1.ResourceQuota
+		limitRange *v1.LimitRange
+		err        error
+	)
+	if r.QuotaPreset != "" {
+		if quota, err = newResourceQuota(r.QuotaPreset, r.Name); err != nil {
+			tool.SendResponse(c, errno.ErrNamespacePreset, err.Error())
+			return
+		}
+	}
+	if r.LimitRangePreset != "" {
+		if limitRange, err = newLimitRange(r.LimitRangePreset, r.Name); err != nil {
+			tool.SendResponse(c, errno.ErrNamespacePreset, err.Error())
+			return
+		}
+	}
+
+	if r.Owner != "" {
+		if _, err := muser.GetUser(r.Owner); err != nil {
+			tool.SendResponse(c, errno.ErrUserNotFound, nil)
+			return
+		}
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	namespace, err := ns.CreateNamespaceWithPresets(clientset, &ns.NamespaceSpec{Name: r.Name}, quota, limitRange)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateNamespace, err.Error())
+		return
+	}
+
+	if r.Owner != "" {
+		n := muser.UserNamespaceModel{
+			Username:  r.Owner,
+			Cluster:   c.GetString(client.ClusterContextKey),
+			Namespace: r.Name,
+		}
+		if err := n.Create(); err != nil {
+			// Roll back the namespace together with its resource quota and limit range so that the request can be retried.
+			deletePropagation := metaV1.DeletePropagationBackground
+			options := metaV1.DeleteOptions{
+				PropagationPolicy: &deletePropagation,
+			}
+			if err := clientset.CoreV1().Namespaces().Delete(context.TODO(), r.Name, options); err != nil {
+				log.Errorf(err, "Failed to roll back namespace %s", r.Name)
+			}
+
+			tool.SendResponse(c, errno.ErrDatabase, nil)
+			return
+		}
+	}
+
+	tool.SendResponse(c, errno.OK, CreateNamespaceResponse{
+		Namespace:     namespace,
+		ResourceQuota: quota,
+		LimitRange:    limitRange,
+	})
+}
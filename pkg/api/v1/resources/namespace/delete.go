@@ -0,0 +1,59 @@
+package namespace
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 删除命名空间
+// @Description 删除命名空间及其中的所有资源，并收回所有用户对该命名空间的访问权限，仅管理员可调用
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body namespace.DeleteNamespaceRequest true "删除参数"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/namespace/delete [delete]
+func Delete(c *gin.Context) {
+	log.Info("调用删除命名空间的函数")
+
+	var r DeleteNamespaceRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	deletePropagation := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{
+		PropagationPolicy: &deletePropagation,
+	}
+	if err := clientset.CoreV1().Namespaces().Delete(context.TODO(), r.Namespace, options); err != nil {
+		tool.SendResponse(c, errno.ErrDeleteNamespace, err.Error())
+		return
+	}
+
+	if err := muser.DeleteNamespaceOwners(c.GetString(client.ClusterContextKey), r.Namespace); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
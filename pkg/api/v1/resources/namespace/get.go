@@ -0,0 +1,43 @@
+package namespace
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	ns "hello-k8s/pkg/kubernetes/kuberesource/resource/namespace"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询某一命名空间的详情
+// @Description 查询某一命名空间的详情，包括其中的 ResourceQuota 和 LimitRange
+// @Tags resource
+// @Produce json
+// @Param namespace path string true "命名空间"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/namespace/detail/{namespace} [get]
+func GetNamespace(c *gin.Context) {
+	log.Debug("调用获取命名空间详情的函数")
+
+	namespace := c.Param("namespace")
+	if namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	detail, err := ns.GetNamespaceDetail(clientset, namespace)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNamespace, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, detail)
+}
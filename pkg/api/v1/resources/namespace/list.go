@@ -0,0 +1,73 @@
+package namespace
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	ns "hello-k8s/pkg/kubernetes/kuberesource/resource/namespace"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取集群中的命名空间列表
+// @Description 管理员获取集群中的所有命名空间，其他用户只能获取自己拥有的命名空间
+// @Tags resource
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,default"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/namespace/list [get]
+func GetNamespaceList(c *gin.Context) {
+	log.Info("调用获取命名空间列表的函数")
+
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrTokenInvalid, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	if muser.IsAdmin(ctx.Username) {
+		list, err := ns.GetNamespaceList(clientset, dsQuery)
+		if err != nil {
+			tool.SendResponse(c, errno.ErrGetNamespaceList, err.Error())
+			return
+		}
+
+		tool.SendResponse(c, errno.OK, list)
+		return
+	}
+
+	owned, err := muser.ListUserNamespace(ctx.Username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	cluster := c.GetString(client.ClusterContextKey)
+	names := make([]string, 0, len(owned))
+	for _, n := range owned {
+		if n.Cluster == cluster {
+			names = append(names, n.Namespace)
+		}
+	}
+
+	list, err := ns.GetNamespaceListByNames(clientset, names, dsQuery)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNamespaceList, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
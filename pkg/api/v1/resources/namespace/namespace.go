@@ -0,0 +1,72 @@
+package namespace
+
+import (
+	"errors"
+	ns "hello-k8s/pkg/kubernetes/kuberesource/resource/namespace"
+
+	"github.com/spf13/viper"
+	v1 "k8s.io/api/core/v1"
+)
+
+var errPresetNotFound = errors.New("preset not found")
+
+// CreateNamespaceRequest 定义了创建一个命名空间时所需参数.
+type CreateNamespaceRequest struct {
+	// Name 命名空间名称.
+	Name string `json:"name"`
+
+	// QuotaPreset 资源配额预设名称，对应配置文件中的 namespace.quota_presets，为空时不创建 ResourceQuota.
+	QuotaPreset string `json:"quotaPreset"`
+
+	// LimitRangePreset 资源限制预设名称，对应配置文件中的 namespace.limit_range_presets，为空时不创建 LimitRange.
+	LimitRangePreset string `json:"limitRangePreset"`
+
+	// Owner 命名空间的拥有者，为空时只有管理员可以访问该命名空间.
+	Owner string `json:"owner"`
+}
+
+// CreateNamespaceResponse 定义了创建命名空间的返回结果.
+type CreateNamespaceResponse struct {
+	// Namespace 创建的命名空间.
+	Namespace *v1.Namespace `json:"namespace"`
+
+	// ResourceQuota 根据预设创建的 ResourceQuota 对象，未指定预设时为空.
+	ResourceQuota *v1.ResourceQuota `json:"resourceQuota,omitempty"`
+
+	// LimitRange 根据预设创建的 LimitRange 对象，未指定预设时为空.
+	LimitRange *v1.LimitRange `json:"limitRange,omitempty"`
+}
+
+// DeleteNamespaceRequest 定义了删除一个命名空间时所需参数.
+type DeleteNamespaceRequest struct {
+	// Namespace 命名空间名称.
+	Namespace string `json:"namespace"`
+}
+
+// Builds the resource quota from the preset in namespace.quota_presets.
+func newResourceQuota(preset, namespace string) (*v1.ResourceQuota, error) {
+	var p ns.ResourceQuotaPreset
+	if err := unmarshalPreset("namespace.quota_presets."+preset, &p); err != nil {
+		return nil, err
+	}
+
+	return ns.NewResourceQuota(preset, namespace, p)
+}
+
+// Builds the limit range from the preset in namespace.limit_range_presets.
+func newLimitRange(preset, namespace string) (*v1.LimitRange, error) {
+	var p ns.LimitRangePreset
+	if err := unmarshalPreset("namespace.limit_range_presets."+preset, &p); err != nil {
+		return nil, err
+	}
+
+	return ns.NewLimitRange(preset, namespace, p)
+}
+
+func unmarshalPreset(key string, preset interface{}) error {
+	if !viper.IsSet(key) {
+		return errPresetNotFound
+	}
+
+	return viper.UnmarshalKey(key, preset)
+}
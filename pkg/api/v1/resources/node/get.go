@@ -0,0 +1,80 @@
+package node
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/node"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询某一 Node 对象的详情
+// @Description 查询某一 Node 对象的详情，包括已分配的资源请求和限制、Pod 和事件，仅管理员可调用
+// @Tags resource
+// @Produce json
+// @Param name path string true "Node 对象名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/node/detail/{name} [get]
+func GetNode(c *gin.Context) {
+	log.Debug("调用获取 Node 对象详情的函数")
+
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	detail, err := node.GetNodeDetail(clientset, nil, name, dsQuery)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNodeDetail, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, detail)
+}
+
+// @Summary 获取运行在某一 Node 对象上的所有 Pod 对象
+// @Description 获取运行在某一 Node 对象上的所有 Pod 对象，仅管理员可调用
+// @Tags resource
+// @Param name path string true "Node 对象名称"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/node/pods/{name} [get]
+func GetNodePods(c *gin.Context) {
+	log.Info("调用获取 Node 对象的 Pod 列表的函数")
+
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	list, err := node.GetNodePods(clientset, nil, dsQuery, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNodePods, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
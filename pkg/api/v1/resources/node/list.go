@@ -0,0 +1,40 @@
+package node
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/node"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取集群中的所有 Node 对象
+// @Description 获取集群中的所有 Node 对象，包括节点的容量和已分配的资源，仅管理员可调用
+// @Tags resource
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,node-1"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/node/list [get]
+func GetNodeList(c *gin.Context) {
+	log.Info("调用获取 Node 对象列表的函数")
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+	list, err := node.GetNodeList(clientset, dsQuery, nil)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNodeList, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, list)
+}
@@ -0,0 +1,120 @@
+package node
+
+import (
+	"context"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/node"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"io"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 将 Node 对象标记为不可调度
+// @Description 将 Node 对象标记为不可调度，已运行的 Pod 不受影响，仅管理员可调用
+// @Tags resource
+// @Produce json
+// @Param name path string true "Node 对象名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/node/cordon/{name} [put]
+func Cordon(c *gin.Context) {
+	log.Info("调用将 Node 对象标记为不可调度的函数")
+
+	setUnschedulable(c, true)
+}
+
+// @Summary 将 Node 对象恢复为可调度
+// @Description 将 Node 对象恢复为可调度，仅管理员可调用
+// @Tags resource
+// @Produce json
+// @Param name path string true "Node 对象名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /resource/node/uncordon/{name} [put]
+func Uncordon(c *gin.Context) {
+	log.Info("调用将 Node 对象恢复为可调度的函数")
+
+	setUnschedulable(c, false)
+}
+
+func setUnschedulable(c *gin.Context, unschedulable bool) {
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := node.SetUnschedulable(clientset, name, unschedulable)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCordonNode, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
+
+// @Summary 驱逐 Node 对象上的 Pod
+// @Description 将 Node 对象标记为不可调度并通过 Eviction API 驱逐其上的 Pod，驱逐遵循 PodDisruptionBudget.
+// @Description DaemonSet 管理的 Pod 和静态 Pod 不会被驱逐. 存在不受控制器管理或使用 emptyDir 的 Pod 时，除非指定 force 或 deleteLocalData，否则不驱逐任何 Pod.
+// @Description 驱逐失败时返回错误原因和失败前已驱逐、跳过的 Pod. 请求体为空时使用默认参数. 仅管理员可调用.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @Param name path string true "Node 对象名称"
+// @param data body node.DrainNodeRequest false "驱逐参数"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{"evicted":[],"skipped":[]}}"
+// @Router /resource/node/drain/{name} [put]
+func Drain(c *gin.Context) {
+	log.Info("调用驱逐 Node 对象上 Pod 的函数")
+
+	name := c.Param("name")
+	if name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// An empty body drains the node with the default options.
+	var r DrainNodeRequest
+	if err := c.ShouldBindJSON(&r); err != nil && err != io.EOF {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.TimeoutSeconds == 0 {
+		r.TimeoutSeconds = defaultDrainTimeoutSeconds
+	}
+	if r.TimeoutSeconds < 0 || r.TimeoutSeconds > maxDrainTimeoutSeconds {
+		tool.SendResponse(c, errno.ErrValidation, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), time.Duration(r.TimeoutSeconds)*time.Second)
+	defer cancel()
+
+	result, err := node.DrainNode(ctx, clientset, name, r.DrainOptions)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDrainNode, DrainNodeFailure{
+			Error:  err.Error(),
+			Result: result,
+		})
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -0,0 +1,27 @@
+package node
+
+import "hello-k8s/pkg/kubernetes/kuberesource/resource/node"
+
+const (
+	// 未指定 timeoutSeconds 时等待被 PodDisruptionBudget 拒绝的驱逐的时间.
+	defaultDrainTimeoutSeconds = 60
+	// 驱逐 Pod 的最长等待时间.
+	maxDrainTimeoutSeconds = 600
+)
+
+// DrainNodeRequest 定义了驱逐节点上 Pod 时所需参数.
+type DrainNodeRequest struct {
+	node.DrainOptions
+
+	// TimeoutSeconds 驱逐被 PodDisruptionBudget 拒绝时的最长重试时间，默认为 60 秒，最长为 600 秒.
+	TimeoutSeconds int `json:"timeoutSeconds"`
+}
+
+// DrainNodeFailure 定义了驱逐节点上 Pod 失败时的返回结果.
+type DrainNodeFailure struct {
+	// Error 失败原因.
+	Error string `json:"error"`
+
+	// Result 失败前已驱逐和跳过的 Pod.
+	Result *node.DrainResult `json:"result"`
+}
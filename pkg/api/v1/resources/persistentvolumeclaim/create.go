@@ -4,15 +4,12 @@ import (
 	"context"
 	"hello-k8s/pkg/kubernetes/client"
 	"hello-k8s/pkg/utils/errno"
-	"strconv"
 
 	"hello-k8s/pkg/utils/tool"
 
 	"github.com/gin-gonic/gin"
 	"github.com/lexkong/log"
-	"github.com/spf13/viper"
 	v1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -33,14 +30,17 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	tool.CreateNamespace(r.Namespace, clientset)
+	if err := tool.CreateNamespace(r.Namespace, clientset); err != nil {
+		tool.SendResponse(c, errno.ErrCreateNamespace, err.Error())
+		return
+	}
 
 	pvc := newPersistentVolumeClaim(r)
 	result, err := clientset.CoreV1().PersistentVolumeClaims(r.Namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
@@ -53,46 +53,10 @@ func Create(c *gin.Context) {
 }
 
 func newPersistentVolumeClaim(r CreatePersistentVolumeClaimRequest) *v1.PersistentVolumeClaim {
-	pvc := v1.PersistentVolumeClaim{
+	return &v1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: r.Name,
 		},
+		Spec: tool.CreatePersistentVolumeClaimSpec(r.PersistentVolumeClaimArgs),
 	}
-
-	spec := v1.PersistentVolumeClaimSpec{}
-
-	if r.StorageClassName != nil {
-		spec.StorageClassName = r.StorageClassName
-	}
-
-	if r.StorageCapacity > 0 {
-		in := strconv.FormatFloat(r.StorageCapacity, 'f', 5, 32)
-		capacity := in + viper.GetString("constants.storage_unit")
-		log.Debugf("capacity is %s", capacity)
-		request, _ := resource.ParseQuantity(capacity)
-		spec.Resources = v1.ResourceRequirements{
-			Requests: v1.ResourceList{
-				v1.ResourceStorage: request,
-			},
-		}
-	}
-
-	if len(r.AccessModes) > 0 {
-		for _, accessMode := range r.AccessModes {
-			var mode v1.PersistentVolumeAccessMode
-			switch accessMode {
-			case "ReadWriteOnce":
-				mode = v1.ReadWriteOnce
-			case "ReadOnlyMany":
-				mode = v1.ReadOnlyMany
-			case "ReadWriteMany":
-				mode = v1.ReadWriteMany
-			}
-			spec.AccessModes = append(spec.AccessModes, mode)
-		}
-	}
-
-	pvc.Spec = spec
-
-	return &pvc
 }
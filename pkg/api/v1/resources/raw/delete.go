@@ -0,0 +1,43 @@
+package raw
+
+import (
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 删除任意类型的对象
+// @Description 按资源类型删除对象，依赖于该对象的子对象会被级联删除.
+// @Description 集群级别的对象使用 _cluster 作为命名空间，只有管理员可以删除.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param kind path string true "资源类型"
+// @param namespace path string true "命名空间，集群级别的对象为 _cluster"
+// @param name path string true "对象名称"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":null}"
+// @Router /resource/raw/{kind}/{namespace}/{name} [delete]
+func DeleteObject(c *gin.Context) {
+	log.Info("调用删除任意类型对象的函数")
+
+	ref, err := objectOf(c)
+	if err != nil {
+		tool.SendResponse(c, err, nil)
+		return
+	}
+
+	verber, err := verberOf(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := verber.Delete(ref.kind, ref.namespaceSet, ref.namespace, ref.name); err != nil {
+		tool.SendResponse(c, errno.ErrDeleteRawResource, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
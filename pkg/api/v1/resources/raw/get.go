@@ -0,0 +1,45 @@
+package raw
+
+import (
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询任意类型的对象
+// @Description 按资源类型查询对象的完整定义，支持内置资源类型和 CRD，资源类型为单数小写形式，如 ingress、horizontalpodautoscaler，CRD 使用其完整名称.
+// @Description 集群级别的对象使用 _cluster 作为命名空间，只有管理员可以访问. format 为 yaml 时直接返回 YAML 格式的对象.
+// @Tags resource
+// @Accept json
+// @Produce json,application/yaml
+// @param kind path string true "资源类型"
+// @param namespace path string true "命名空间，集群级别的对象为 _cluster"
+// @param name path string true "对象名称"
+// @param format query string false "返回格式，可选值为 json 和 yaml，默认为 json"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"demo","namespace":"default"}}}"
+// @Router /resource/raw/{kind}/{namespace}/{name} [get]
+func GetObject(c *gin.Context) {
+	log.Debug("调用查询任意类型对象的函数")
+
+	ref, err := objectOf(c)
+	if err != nil {
+		tool.SendResponse(c, err, nil)
+		return
+	}
+
+	verber, err := verberOf(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	object, err := verber.Get(ref.kind, ref.namespaceSet, ref.namespace, ref.name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetRawResource, err.Error())
+		return
+	}
+
+	sendObject(c, object)
+}
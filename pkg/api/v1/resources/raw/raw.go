@@ -0,0 +1,95 @@
+package raw
+
+import (
+	"encoding/json"
+	"hello-k8s/pkg/kubernetes/client"
+	clientapi "hello-k8s/pkg/kubernetes/kuberesource/client/api"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ClusterScope 是请求路径中表示集群级别资源的命名空间占位符，只有管理员可以使用.
+	ClusterScope = "_cluster"
+
+	// 请求体的最大长度.
+	maxObjectBytes = 2 << 20
+)
+
+// The object addressed by the request path.
+type objectRef struct {
+	kind string
+	// False for cluster scoped kinds.
+	namespaceSet bool
+	namespace    string
+	name         string
+}
+
+// Returns the object addressed by the request path. Only administrators can
+// access cluster scoped objects.
+func objectOf(c *gin.Context) (*objectRef, error) {
+	ref := &objectRef{
+		kind:      strings.ToLower(c.Param("kind")),
+		namespace: c.Param("namespace"),
+		name:      c.Param("name"),
+	}
+	if ref.kind == "" || ref.namespace == "" || ref.name == "" {
+		return nil, errno.ErrBadParam
+	}
+
+	if ref.namespace != ClusterScope {
+		ref.namespaceSet = true
+		return ref, nil
+	}
+
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		return nil, errno.ErrTokenInvalid
+	}
+	if !muser.IsAdmin(ctx.Username) {
+		return nil, errno.ErrPermissionDenied
+	}
+
+	ref.namespace = ""
+	return ref, nil
+}
+
+// Returns the shared resource verber of the cluster of the request.
+func verberOf(c *gin.Context) (clientapi.ResourceVerber, error) {
+	m, err := client.ManagerFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.Verber()
+}
+
+// Sends the object as YAML when the format query is yaml, otherwise the
+// object is sent as the data of a JSON response.
+func sendObject(c *gin.Context, object runtime.Object) {
+	raw := []byte("null")
+	if unknown, ok := object.(*runtime.Unknown); ok && len(unknown.Raw) > 0 {
+		raw = unknown.Raw
+	}
+
+	if c.Query("format") != "yaml" {
+		tool.SendResponse(c, errno.OK, json.RawMessage(raw))
+		return
+	}
+
+	content, err := yaml.JSONToYAML(raw)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetRawResource, err.Error())
+		return
+	}
+
+	c.Data(http.StatusOK, "application/yaml; charset=utf-8", content)
+}
@@ -0,0 +1,72 @@
+package raw
+
+import (
+	"encoding/json"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+// @Summary 替换任意类型的对象
+// @Description 使用请求体中 JSON 或 YAML 格式的对象替换已存在的对象，请求体中的 metadata.resourceVersion 用于冲突检测，返回更新后的对象.
+// @Description 集群级别的对象使用 _cluster 作为命名空间，只有管理员可以修改.
+// @Tags resource
+// @Accept json,application/yaml
+// @Produce json,application/yaml
+// @param kind path string true "资源类型"
+// @param namespace path string true "命名空间，集群级别的对象为 _cluster"
+// @param name path string true "对象名称"
+// @param format query string false "返回格式，可选值为 json 和 yaml，默认为 json"
+// @param data body object true "对象的完整定义"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"demo","namespace":"default"}}}"
+// @Router /resource/raw/{kind}/{namespace}/{name} [put]
+func UpdateObject(c *gin.Context) {
+	log.Info("调用替换任意类型对象的函数")
+
+	ref, err := objectOf(c)
+	if err != nil {
+		tool.SendResponse(c, err, nil)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxObjectBytes))
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	// JSON is valid YAML, only the bodies which are not JSON are converted.
+	if !json.Valid(body) {
+		if body, err = yaml.YAMLToJSON(body); err != nil {
+			tool.SendResponse(c, errno.ErrBind, err.Error())
+			return
+		}
+	}
+
+	verber, err := verberOf(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	err = verber.Put(ref.kind, ref.namespaceSet, ref.namespace, ref.name, &runtime.Unknown{Raw: body})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateRawResource, err.Error())
+		return
+	}
+
+	object, err := verber.Get(ref.kind, ref.namespaceSet, ref.namespace, ref.name)
+	if err != nil {
+		log.Errorf(err, "Failed to get the updated %s %q", ref.kind, ref.name)
+		tool.SendResponse(c, errno.OK, nil)
+		return
+	}
+
+	sendObject(c, object)
+}
@@ -0,0 +1,53 @@
+package scale
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/scaling"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询工作负载的副本数.
+// @Description 查询 Deployment、StatefulSet、ReplicaSet 或 ReplicationController 对象的期望副本数和实际副本数.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param kind path string true "资源类型，可选值为 deployment、statefulset、replicaset 和 replicationcontroller"
+// @param name path string true "对象名称"
+// @param namespace path string true "命名空间"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{"desiredReplicas":1,"actualReplicas":1}}"
+// @Router /resource/scale/{kind}/{name}/{namespace} [get]
+func GetReplicaCounts(c *gin.Context) {
+	log.Debug("调用查询副本数的函数.")
+
+	resource, ok := resourceOf(c.Param("kind"))
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if !ok || name == "" || namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	m, err := client.ManagerFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	sc, err := scaling.NewScalesGetter(m.Config(), m.DiscoveryClient(), m.RESTMapper())
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, err.Error())
+		return
+	}
+
+	counts, err := scaling.GetReplicaCountsWithGetter(sc, resource, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetReplicaCounts, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, counts)
+}
@@ -0,0 +1,40 @@
+package scale
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ScaleRequest 定义了调整副本数时所需参数.
+type ScaleRequest struct {
+	// Replicas 期望的副本数.
+	Replicas *int32 `json:"replicas"`
+}
+
+// 支持调整副本数的资源类型，键为请求中的资源类型，值为对应的资源名称.
+var scalableResources = map[string]string{
+	"deployment":            "deployments",
+	"statefulset":           "statefulsets",
+	"replicaset":            "replicasets",
+	"replicationcontroller": "replicationcontrollers",
+}
+
+// Returns the resource name of the scalable kind, both singular and plural
+// kinds are accepted.
+func resourceOf(kind string) (string, bool) {
+	kind = strings.TrimSuffix(strings.ToLower(kind), "s")
+	resource, ok := scalableResources[kind]
+	return resource, ok
+}
+
+// Returns true if the replicas is allowed. scale.max_replicas limits the
+// replicas when it is set.
+func validReplicas(replicas int32) bool {
+	if replicas < 0 {
+		return false
+	}
+
+	max := viper.GetInt("scale.max_replicas")
+	return max <= 0 || int(replicas) <= max
+}
@@ -0,0 +1,66 @@
+package scale
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/scaling"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 调整工作负载的副本数.
+// @Description 调整 Deployment、StatefulSet、ReplicaSet 或 ReplicationController 对象的副本数.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param kind path string true "资源类型，可选值为 deployment、statefulset、replicaset 和 replicationcontroller"
+// @param name path string true "对象名称"
+// @param namespace path string true "命名空间"
+// @param data body scale.ScaleRequest true "期望的副本数"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{"desiredReplicas":1,"actualReplicas":1}}"
+// @Router /resource/scale/{kind}/{name}/{namespace} [put]
+func ScaleResource(c *gin.Context) {
+	log.Info("调用调整副本数的函数.")
+
+	resource, ok := resourceOf(c.Param("kind"))
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if !ok || name == "" || namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	var r ScaleRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err.Error())
+		return
+	}
+
+	if r.Replicas == nil || !validReplicas(*r.Replicas) {
+		tool.SendResponse(c, errno.ErrValidation, nil)
+		return
+	}
+
+	m, err := client.ManagerFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	sc, err := scaling.NewScalesGetter(m.Config(), m.DiscoveryClient(), m.RESTMapper())
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, err.Error())
+		return
+	}
+
+	counts, err := scaling.ScaleResourceWithGetter(sc, resource, namespace, name, strconv.Itoa(int(*r.Replicas)))
+	if err != nil {
+		tool.SendResponse(c, errno.ErrScaleDeployment, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, counts)
+}
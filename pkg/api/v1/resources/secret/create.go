@@ -29,14 +29,17 @@ func Create(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	tool.CreateNamespace(r.Namespace, clientset)
+	if err := tool.CreateNamespace(r.Namespace, clientset); err != nil {
+		tool.SendResponse(c, errno.ErrCreateNamespace, err.Error())
+		return
+	}
 
 	s := newSecret(r)
 	result, err := clientset.CoreV1().Secrets(r.Namespace).Create(context.TODO(), s, metav1.CreateOptions{})
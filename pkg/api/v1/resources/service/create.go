@@ -0,0 +1,60 @@
+package service
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/service"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 创建 Service 对象
+// @Description 创建 ClusterIP、NodePort、LoadBalancer 或 headless 类型的 Service 对象. 选择器必须匹配命名空间中至少一个 Pod，nodePort 必须在集群配置的 NodePort 范围内且没有被其他 Service 使用，LoadBalancer 类型不支持 UDP 协议.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body service.ServiceRequest true "创建 Service 对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/service/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 Service 对象的函数")
+
+	var r ServiceRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	spec, err := toServiceSpec(r.Service)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := service.ValidateSpec(clientset, r.Namespace, r.Name, spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	result, err := service.CreateService(clientset, r.Namespace, r.Name, spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateService, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -12,7 +12,7 @@ import (
 )
 
 // @Summary  查询某一 Service 对象的详情
-// @Description 查询某一 Service 对象的详情
+// @Description 查询某一 Service 对象的详情，endpointList 中列出流量转发到的后端地址及其是否就绪
 // @Tags resource
 // @Accept json
 // @Produce json
@@ -30,8 +30,8 @@ func GetService(c *gin.Context) {
 		return
 	}
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
@@ -1,5 +1,16 @@
 package service
 
+import (
+	"fmt"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/service"
+	"hello-k8s/pkg/kubernetes/kuberesource/validation"
+	"hello-k8s/pkg/model"
+	"hello-k8s/pkg/utils/tool"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
 // DeleteServiceRequest 定义了删除一个 Service 对象时所需参数.
 type DeleteServiceRequest struct {
 	// Name Service 对象名称.
@@ -11,3 +22,50 @@ type DeleteServiceRequest struct {
 	// ClusterID Kubernetes 集群ID.
 	ClusterID string `json:"clusterId,omitempty"`
 }
+
+// ServiceRequest 定义了创建或更新一个 Service 对象时所需参数.
+type ServiceRequest struct {
+	// Name Service 对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间
+	Namespace string `json:"namespace"`
+
+	// Service 对象的类型、选择器和端口.
+	Service model.ServiceArgs `json:"service"`
+}
+
+// Checks the ports of the request and converts it to the spec of the service.
+func toServiceSpec(args model.ServiceArgs) (service.Spec, error) {
+	spec := service.Spec{
+		Type:     args.Type,
+		Headless: args.Headless,
+		Selector: tool.GetLabelsMap(args.Selector),
+		Ports:    tool.CreateServicePorts(args.Ports),
+	}
+
+	protocols := deployment.GetAvailableProtocols().Protocols
+	for _, port := range spec.Ports {
+		if port.Port < 1 || port.Port > 65535 || port.TargetPort.IntVal < 1 || port.TargetPort.IntVal > 65535 {
+			return spec, fmt.Errorf("port %d or target port %d is out of range", port.Port, port.TargetPort.IntVal)
+		}
+
+		supported := false
+		for _, protocol := range protocols {
+			if port.Protocol == protocol {
+				supported = true
+				break
+			}
+		}
+
+		validity := validation.ValidateProtocol(&validation.ProtocolValiditySpec{
+			Protocol:   port.Protocol,
+			IsExternal: args.Type == corev1.ServiceTypeLoadBalancer,
+		})
+		if !supported || !validity.Valid {
+			return spec, fmt.Errorf("protocol %s is not supported for a %s service", port.Protocol, args.Type)
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/service"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 更新 Service 对象
+// @Description 替换 Service 对象的类型、选择器和端口，校验规则与创建时相同. 未指定 nodePort 的端口保留原来分配的 nodePort，Service 不能在 headless 与非 headless 之间切换.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body service.ServiceRequest true "更新 Service 对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/service/update [put]
+func Update(c *gin.Context) {
+	log.Info("调用更新 Service 对象的函数")
+
+	var r ServiceRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	spec, err := toServiceSpec(r.Service)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	if err := service.ValidateSpec(clientset, r.Namespace, r.Name, spec); err != nil {
+		tool.SendResponse(c, errno.ErrBadParam, err.Error())
+		return
+	}
+
+	result, err := service.UpdateService(clientset, r.Namespace, r.Name, spec)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateService, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
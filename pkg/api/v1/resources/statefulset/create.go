@@ -0,0 +1,173 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// @Summary 创建StatefulSet对象
+// @Description 创建StatefulSet对象和同名的Headless Service对象，同名的Service对象已存在时直接使用，命名空间必须已经存在. VolumeClaimTemplates 中的每个模板会为每个 Pod 创建一个 PersistentVolumeClaim 对象并挂载到容器中
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body statefulset.CreateStatefulSetRequest true "创建StatefulSet对象所需参数."
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/statefulset/create [post]
+func Create(c *gin.Context) {
+	log.Info("调用创建 StatefulSet 对象的函数")
+
+	var r CreateStatefulSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || !validRequest(r) {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if r.StatefulSet.Replicas != nil && *r.StatefulSet.Replicas < 0 {
+		tool.SendResponse(c, errno.ErrBadParam, "replicas must not be negative")
+		return
+	}
+
+	// StatefulSet 对象只支持 Always 重启策略.
+	if policy := r.StatefulSet.PodTemplate.RestartPolicy; policy != "" && policy != api.RestartPolicyAlways {
+		tool.SendResponse(c, errno.ErrBadParam, "restartPolicy must be Always")
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	exists, err := tool.NamespaceExists(r.Namespace, clientset)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetNamespace, err.Error())
+		return
+	}
+	if !exists {
+		tool.SendResponse(c, errno.ErrNamespaceNotFound, nil)
+		return
+	}
+
+	statefulSet, service, err := statefulset.CreateStatefulSet(clientset, r.Namespace, newStatefulSet(r), newHeadlessService(r))
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateStatefulSet, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, CreateStatefulSetResponse{
+		StatefulSet: statefulSet,
+		Service:     service,
+	})
+}
+
+// Returns false if the pod management policy is unknown or the volume claim
+// templates have empty or duplicated names.
+func validRequest(r CreateStatefulSetRequest) bool {
+	policy := r.StatefulSet.PodManagementPolicy
+	if policy != "" && policy != apps.OrderedReadyPodManagement && policy != apps.ParallelPodManagement {
+		return false
+	}
+
+	names := make(map[string]bool)
+	for _, template := range r.StatefulSet.VolumeClaimTemplates {
+		if template.Name == "" || template.MountPath == "" || names[template.Name] {
+			return false
+		}
+		names[template.Name] = true
+	}
+
+	return true
+}
+
+func newStatefulSet(r CreateStatefulSetRequest) *apps.StatefulSet {
+	selector, labels := tool.GetWorkloadLabels(r.Name, r.StatefulSet.Selector, r.StatefulSet.PodTemplate.Labels)
+
+	objectMeta := metaV1.ObjectMeta{
+		Name:   r.Name,
+		Labels: labels,
+	}
+
+	podSpec := tool.CreatePodSpec(r.Name, r.StatefulSet.PodTemplate)
+
+	var claimTemplates []api.PersistentVolumeClaim
+	for _, template := range r.StatefulSet.VolumeClaimTemplates {
+		spec := tool.CreatePersistentVolumeClaimSpec(template.PersistentVolumeClaimArgs)
+		if len(spec.AccessModes) == 0 {
+			spec.AccessModes = []api.PersistentVolumeAccessMode{api.ReadWriteOnce}
+		}
+
+		claimTemplates = append(claimTemplates, api.PersistentVolumeClaim{
+			ObjectMeta: metaV1.ObjectMeta{
+				Name:   template.Name,
+				Labels: selector,
+			},
+			Spec: spec,
+		})
+
+		podSpec.Containers[0].VolumeMounts = append(podSpec.Containers[0].VolumeMounts, api.VolumeMount{
+			Name:      template.Name,
+			MountPath: template.MountPath,
+			ReadOnly:  template.ReadOnly,
+		})
+	}
+
+	replicas := int32(1)
+	if r.StatefulSet.Replicas != nil {
+		replicas = *r.StatefulSet.Replicas
+	}
+
+	return &apps.StatefulSet{
+		ObjectMeta: objectMeta,
+		Spec: apps.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: r.Name,
+			Selector: &metaV1.LabelSelector{
+				MatchLabels: selector,
+			},
+			Template: api.PodTemplateSpec{
+				ObjectMeta: objectMeta,
+				Spec:       *podSpec,
+			},
+			VolumeClaimTemplates: claimTemplates,
+			PodManagementPolicy:  r.StatefulSet.PodManagementPolicy,
+			UpdateStrategy: apps.StatefulSetUpdateStrategy{
+				Type: apps.RollingUpdateStatefulSetStrategyType,
+			},
+		},
+	}
+}
+
+func newHeadlessService(r CreateStatefulSetRequest) *api.Service {
+	selector, _ := tool.GetWorkloadLabels(r.Name, r.StatefulSet.Selector, r.StatefulSet.PodTemplate.Labels)
+
+	service := &api.Service{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:   r.Name,
+			Labels: tool.GetLabelsMap(r.StatefulSet.PodTemplate.Labels),
+		},
+		Spec: api.ServiceSpec{
+			Selector:  selector,
+			ClusterIP: api.ClusterIPNone,
+			Type:      api.ServiceTypeClusterIP,
+		},
+	}
+
+	service.Spec.Ports = tool.CreateServicePortsFromMappings(r.StatefulSet.PortMappings)
+
+	return service
+}
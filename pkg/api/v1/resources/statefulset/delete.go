@@ -0,0 +1,49 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 删除指定StatefulSet对象.
+// @Description 删除指定StatefulSet对象及其管理的Pod，以及创建StatefulSet对象时一起创建的Headless Service对象，可以选择同时删除由 VolumeClaimTemplates 创建的 PersistentVolumeClaim 对象，默认保留.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body statefulset.DeleteStatefulSetRequest true "删除一个StatefulSet对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{"persistentVolumeClaims":["data-db-0"]}}"
+// @Router /resource/statefulset/delete [delete]
+func Delete(c *gin.Context) {
+	log.Info("调用删除 StatefulSet 对象的函数.")
+
+	var r DeleteStatefulSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	claims, err := statefulset.DeleteStatefulSet(clientset, r.Namespace, r.Name, r.DeletePersistentVolumeClaims)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDeleteStatefulSet, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, DeleteStatefulSetResponse{PersistentVolumeClaims: claims})
+}
@@ -0,0 +1,51 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 查询某一 StatefulSet 对象的事件列表
+// @Description 查询某一 StatefulSet 对象及其管理的 Pod 的事件，如 PersistentVolumeClaim 绑定失败等
+// @Tags resource
+// @Accept json
+// @Produce json
+// @Param name path string true "StatefulSet 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,lastSeen"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/statefulset/events/{name}/{namespace} [get]
+func GetStatefulSetEvents(c *gin.Context) {
+	log.Info("调用获取 StatefulSet 对象事件列表的函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	dsQuery := tool.ParseDataSelectQuery(c)
+
+	events, err := statefulset.GetStatefulSetEvents(clientset, dsQuery, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetEvents, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, events)
+}
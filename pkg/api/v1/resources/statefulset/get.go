@@ -0,0 +1,46 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary  查询某一 StatefulSet 对象的详情
+// @Description 查询某一 StatefulSet 对象的详情，包括 Pod 状态、更新策略和滚动更新进度
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param name path string true "StatefulSet 对象名称"
+// @Param namespace path string true "用户的命名空间"
+// @Success 200 {object} tool.Response "{"code":200, "message":"OK", "data":{""}}"
+// @Router /resource/statefulset/detail/{name}/{namespace} [get]
+func GetStatefulSet(c *gin.Context) {
+	log.Info("调用查询 StatefulSet 对象详情的函数")
+
+	name := c.Param("name")
+	namespace := c.Param("namespace")
+	if namespace == "" || name == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := statefulset.GetStatefulSetDetail(clientset, nil, namespace, name)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrGetStatefulSet, err)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -0,0 +1,59 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/model"
+
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+)
+
+// CreateStatefulSetRequest 定义了创建一个StatefulSet对象时所需的参数
+type CreateStatefulSetRequest struct {
+	// StatefulSet 对象名称，同时也是 Headless Service 对象的名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// StatefulSet statefulset对象参数.
+	StatefulSet model.StatefulSetArgs `json:"statefulSet"`
+}
+
+// CreateStatefulSetResponse 定义了创建StatefulSet对象的返回结果.
+type CreateStatefulSetResponse struct {
+	// StatefulSet 创建的StatefulSet对象.
+	StatefulSet *apps.StatefulSet `json:"statefulSet"`
+
+	// Service 同时创建或已存在的Headless Service对象.
+	Service *api.Service `json:"service"`
+}
+
+// UpdateStatefulSetRequest 定义了分批滚动更新StatefulSet对象时所需参数.
+type UpdateStatefulSetRequest struct {
+	// Name StatefulSet对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	statefulset.UpdateOptions
+}
+
+// DeleteStatefulSetRequest 定义了删除一个StatefulSet对象时所需参数.
+type DeleteStatefulSetRequest struct {
+	// Name StatefulSet对象名称.
+	Name string `json:"name"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+
+	// DeletePersistentVolumeClaims 是否同时删除由 VolumeClaimTemplates 创建的 PersistentVolumeClaim 对象.
+	DeletePersistentVolumeClaims bool `json:"deletePersistentVolumeClaims"`
+}
+
+// DeleteStatefulSetResponse 定义了删除StatefulSet对象的返回结果.
+type DeleteStatefulSetResponse struct {
+	// PersistentVolumeClaims 被删除的PersistentVolumeClaim对象名称.
+	PersistentVolumeClaims []string `json:"persistentVolumeClaims"`
+}
@@ -0,0 +1,51 @@
+package statefulset
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/statefulset"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 分批滚动更新StatefulSet对象.
+// @Description 更新StatefulSet对象中指定容器的镜像，只有序号大于等于 partition 的 Pod 会被更新. 逐步调小 partition 直到 0 即可完成全部 Pod 的更新.
+// @Description container 为空时只修改 partition，partition 为空时保持原有的值.
+// @Tags resource
+// @Accept json
+// @Produce json
+// @param data body statefulset.UpdateStatefulSetRequest true "滚动更新StatefulSet对象时所需参数."
+// @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
+// @Router /resource/statefulset/update [put]
+func Update(c *gin.Context) {
+	log.Info("调用滚动更新 StatefulSet 对象的函数.")
+
+	var r UpdateStatefulSetRequest
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Name == "" || r.Namespace == "" || (r.Container != "" && r.Image == "") ||
+		(r.Container == "" && r.Partition == nil) || (r.Partition != nil && *r.Partition < 0) {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	result, err := statefulset.UpdateStatefulSet(clientset, r.Namespace, r.Name, r.UpdateOptions)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUpdateStatefulSet, err.Error())
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, result)
+}
@@ -2,7 +2,6 @@ package storageclass
 
 import (
 	"hello-k8s/pkg/kubernetes/client"
-	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/storageclass"
 	"hello-k8s/pkg/utils/errno"
 
@@ -15,19 +14,23 @@ import (
 // @Summary 获取所有 StorageClass 对象列表.
 // @Description 获取某一用户创建的所有Job对象
 // @Tags resource
+// @Param itemsPerPage query int false "每页数量"
+// @Param page query int false "页码，从 1 开始"
+// @Param sortBy query string false "排序条件，例如 d,creationTimestamp"
+// @Param filterBy query string false "过滤条件，例如 name,nginx"
 // @Success 200 {object} tool.Response "{"code":200,"message":"OK","data":{""}}"
 // @Router /resource/storageclass/list [get]
 func GetStorageClassList(c *gin.Context) {
 	log.Debug("调用获取 StorageClass 对象列表的函数.")
 
-	// Init kubernetes client
-	clientset, err := client.New()
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
 		return
 	}
 
-	dsQuery := dataselect.NewDataSelectQuery(dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter, dataselect.NoMetrics)
+	dsQuery := tool.ParseDataSelectQuery(c)
 	list, err := storageclass.GetStorageClassList(clientset, dsQuery)
 	if err != nil {
 		tool.SendResponse(c, errno.ErrGetStorageClassList, err)
@@ -0,0 +1,64 @@
+package terminal
+
+import (
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+	"hello-k8s/pkg/utils/wsutil"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/lexkong/log"
+)
+
+// @Summary 连接终端会话.
+// @Description 将 get 请求升级为 WebSocket 协议并连接由 /resource/container/shell 创建的终端会话，会话只能由创建者连接一次.
+// @Description 消息格式为 {"Op":"","Data":"","Rows":0,"Cols":0}，客户端发送 stdin 和 resize 消息，服务端发送 stdout 和 toast 消息.
+// @Description 超过 terminal.idle_timeout（默认 10 分钟）没有输入时关闭终端.
+// @Tags resource
+// @Param id path string true "终端会话 ID"
+// @Param token query string false "WebSocket 请求无法设置 Authorization 头时使用的 token"
+// @Success 101 {object} terminal.TerminalMessage "{"Op":"stdout","Data":""}"
+// @Router /v1/terminal/{id} [get]
+func Attach(c *gin.Context) {
+	log.Debug("连接终端会话.")
+
+	id := c.Param("id")
+	if id == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrTokenInvalid, nil)
+		return
+	}
+
+	ws, err := wsutil.Upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Errorf(err, "升级 get 请求为 webSocket 协议失败")
+		return
+	}
+
+	s, err := terminalSessions.Bind(id, ctx.Username, ws)
+	if err != nil {
+		wsutil.Close(ws, websocket.ClosePolicyViolation, err.Error())
+		ws.Close()
+		return
+	}
+	defer terminalSessions.Remove(s.id)
+
+	go s.keepalive()
+
+	err = s.run()
+	close(s.done)
+	if err != nil {
+		log.Errorf(err, "终端会话 %s 异常退出", s.id)
+		s.Toast(err.Error())
+		s.close(websocket.CloseInternalServerErr, err.Error())
+		return
+	}
+
+	s.close(websocket.CloseNormalClosure, "Process exited")
+}
@@ -0,0 +1,74 @@
+package terminal
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// @Summary 创建某一 Container 对象的终端会话.
+// @Description 创建在 Container 中执行 shell 的终端会话，返回的会话 ID 需要在 30 秒内通过 /v1/terminal/{id} 连接，否则会话失效.
+// @Description 未指定 shell 或 shell 无效时，依次尝试 bash 和 sh.
+// @Tags resource
+// @Produce json
+// @Param namespace path string true "命名空间"
+// @Param podId path string true "PodID"
+// @Param container path string true "Container"
+// @Param shell query string false "使用的 shell，可选 bash、sh"
+// @Success 200 {object} terminal.ShellResponse "{"code":0,"message":"OK","data":{"id":""}}"
+// @Router /resource/container/shell/{namespace}/{podId}/{container} [get]
+func CreateSession(c *gin.Context) {
+	log.Debug("创建某一 Container 对象的终端会话.")
+
+	namespace := c.Param("namespace")
+	podID := c.Param("podId")
+	containerName := c.Param("container")
+	if namespace == "" || podID == "" || containerName == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrTokenInvalid, nil)
+		return
+	}
+
+	// Get kubernetes client
+	clientset, err := client.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+	config, err := client.ConfigFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateK8sClientSet, nil)
+		return
+	}
+
+	id, err := genTerminalSessionID()
+	if err != nil {
+		tool.SendResponse(c, errno.ErrCreateTerminal, err.Error())
+		return
+	}
+
+	terminalSessions.Add(&session{
+		id:        id,
+		username:  ctx.Username,
+		namespace: namespace,
+		pod:       podID,
+		container: containerName,
+		shell:     c.Query("shell"),
+		client:    clientset,
+		config:    config,
+		sizeChan:  make(chan remotecommand.TerminalSize),
+		done:      make(chan struct{}),
+	})
+
+	tool.SendResponse(c, nil, ShellResponse{ID: id})
+}
@@ -0,0 +1,316 @@
+package terminal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hello-k8s/pkg/utils/wsutil"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/spf13/viper"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
+)
+
+const (
+	endOfTransmission = "\u0004"
+
+	// Sessions that are not attached within this time are removed.
+	bindTimeout = 30 * time.Second
+	// 未配置 terminal.idle_timeout 时，终端在没有输入的情况下保持的时间.
+	defaultIdleTimeout = 10 * time.Minute
+)
+
+// 未指定 shell 或 shell 无效时依次尝试的 shell.
+var validShells = []string{"bash", "sh"}
+
+var (
+	errSessionNotFound = errors.New("terminal session not found")
+	errSessionBound    = errors.New("terminal session is already attached")
+)
+
+// ShellResponse 定义了创建终端会话的返回结果.
+type ShellResponse struct {
+	// ID 终端会话 ID，用于连接终端.
+	ID string `json:"id"`
+}
+
+// TerminalMessage is the messaging protocol between the terminal and the peer.
+//
+// OP      DIRECTION  FIELD(S) USED  DESCRIPTION
+// ---------------------------------------------------------------------
+// stdin   fe->be     Data           Keystrokes/paste buffer
+// resize  fe->be     Rows, Cols     New terminal size
+// stdout  be->fe     Data           Output from the process
+// toast   be->fe     Data           OOB message to be shown to the user
+type TerminalMessage struct {
+	Op, Data, SessionID string
+	Rows, Cols          uint16
+}
+
+// session is an exec session of a container. It is created by CreateSession
+// and attached to a WebSocket connection by Attach.
+type session struct {
+	id       string
+	username string
+
+	namespace string
+	pod       string
+	container string
+	shell     string
+
+	client kubernetes.Interface
+	config *rest.Config
+
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+	sizeChan  chan remotecommand.TerminalSize
+	done      chan struct{}
+}
+
+// Next handles pty->process resize events.
+func (s *session) Next() *remotecommand.TerminalSize {
+	select {
+	case size := <-s.sizeChan:
+		return &size
+	case <-s.done:
+		return nil
+	}
+}
+
+// Read handles pty->process messages (stdin, resize). The process is
+// terminated when there is no input within the idle timeout.
+func (s *session) Read(p []byte) (int, error) {
+	s.conn.SetReadDeadline(time.Now().Add(idleTimeout()))
+	_, m, err := s.conn.ReadMessage()
+	if err != nil {
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			s.Toast(fmt.Sprintf("No input for %s, closing the terminal", idleTimeout()))
+		}
+		// Send terminated signal to process to avoid resource leak
+		return copy(p, endOfTransmission), err
+	}
+
+	var msg TerminalMessage
+	if err := json.Unmarshal(m, &msg); err != nil {
+		return copy(p, endOfTransmission), err
+	}
+
+	switch msg.Op {
+	case "stdin":
+		return copy(p, msg.Data), nil
+	case "resize":
+		select {
+		case s.sizeChan <- remotecommand.TerminalSize{Width: msg.Cols, Height: msg.Rows}:
+		case <-s.done:
+		}
+		return 0, nil
+	default:
+		return copy(p, endOfTransmission), fmt.Errorf("unknown message type '%s'", msg.Op)
+	}
+}
+
+// Write handles process->pty stdout.
+func (s *session) Write(p []byte) (int, error) {
+	if err := s.send("stdout", string(p)); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Toast sends the user an OOB message.
+func (s *session) Toast(p string) error {
+	return s.send("toast", p)
+}
+
+func (s *session) send(op, data string) error {
+	msg, err := json.Marshal(TerminalMessage{
+		Op:   op,
+		Data: data,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	s.conn.SetWriteDeadline(time.Now().Add(wsutil.WriteWait))
+	return s.conn.WriteMessage(websocket.TextMessage, msg)
+}
+
+// Sends pings until the session is done.
+func (s *session) keepalive() {
+	ticker := time.NewTicker(wsutil.PingPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.writeLock.Lock()
+			err := wsutil.Ping(s.conn)
+			s.writeLock.Unlock()
+			if err != nil {
+				return
+			}
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// Closes the connection with the reason shown to the user.
+func (s *session) close(code int, reason string) {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	wsutil.Close(s.conn, code, reason)
+	s.conn.Close()
+}
+
+// Runs the shell in the container. The shells in validShells are tried in
+// order if no valid shell was requested, the next one is only tried when the
+// previous one does not exist in the container.
+func (s *session) run() error {
+	if isValidShell(s.shell) {
+		return s.exec([]string{s.shell})
+	}
+
+	var err error
+	for _, shell := range validShells {
+		if err = s.exec([]string{shell}); err == nil || !isShellNotFound(err) {
+			return err
+		}
+	}
+
+	return err
+}
+
+// Returns true if the shell could not be executed because it does not exist
+// in the container, or is not executable.
+func isShellNotFound(err error) bool {
+	if exitErr, ok := err.(utilexec.ExitError); ok {
+		if code := exitErr.ExitStatus(); code == 126 || code == 127 {
+			return true
+		}
+	}
+
+	return strings.Contains(err.Error(), "executable file not found")
+}
+
+// Executes the command in the container and connects it up with the session.
+func (s *session) exec(cmd []string) error {
+	req := s.client.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Name(s.pod).
+		Namespace(s.namespace).
+		SubResource("exec")
+
+	req.VersionedParams(&v1.PodExecOptions{
+		Container: s.container,
+		Command:   cmd,
+		Stdin:     true,
+		Stdout:    true,
+		Stderr:    true,
+		TTY:       true,
+	}, scheme.ParameterCodec)
+
+	executor, err := remotecommand.NewSPDYExecutor(s.config, "POST", req.URL())
+	if err != nil {
+		return err
+	}
+
+	return executor.Stream(remotecommand.StreamOptions{
+		Stdin:             s,
+		Stdout:            s,
+		Stderr:            s,
+		TerminalSizeQueue: s,
+		Tty:               true,
+	})
+}
+
+// sessionMap stores the terminal sessions.
+type sessionMap struct {
+	sessions map[string]*session
+	lock     sync.Mutex
+}
+
+var terminalSessions = sessionMap{sessions: make(map[string]*session)}
+
+// Add stores the session. The session is removed if it is not attached
+// within bindTimeout.
+func (sm *sessionMap) Add(s *session) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	sm.sessions[s.id] = s
+
+	time.AfterFunc(bindTimeout, func() {
+		sm.lock.Lock()
+		defer sm.lock.Unlock()
+		if s, ok := sm.sessions[s.id]; ok && s.conn == nil {
+			delete(sm.sessions, s.id)
+		}
+	})
+}
+
+// Bind attaches the connection to the session created by the user.
+func (sm *sessionMap) Bind(id, username string, conn *websocket.Conn) (*session, error) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+
+	s, ok := sm.sessions[id]
+	if !ok || s.username != username {
+		return nil, errSessionNotFound
+	}
+	if s.conn != nil {
+		return nil, errSessionBound
+	}
+
+	s.conn = conn
+	return s, nil
+}
+
+// Remove deletes the session.
+func (sm *sessionMap) Remove(id string) {
+	sm.lock.Lock()
+	defer sm.lock.Unlock()
+	delete(sm.sessions, id)
+}
+
+// Generates a random session ID.
+func genTerminalSessionID() (string, error) {
+	bytes := make([]byte, 16)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
+
+func isValidShell(shell string) bool {
+	for _, validShell := range validShells {
+		if validShell == shell {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns terminal.idle_timeout, defaults to defaultIdleTimeout.
+func idleTimeout() time.Duration {
+	if timeout := viper.GetDuration("terminal.idle_timeout"); timeout > 0 {
+		return timeout
+	}
+
+	return defaultIdleTimeout
+}
@@ -2,7 +2,10 @@ package sd
 
 import (
 	"fmt"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/model"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/shirou/gopsutil/cpu"
@@ -97,3 +100,45 @@ func RAMCheck(c *gin.Context) {
 	message := fmt.Sprintf("%s - Free space: %dMB (%dGB) / %dMB (%dGB) | Used: %d%%", text, usedMB, usedGB, totalMB, totalGB, usedPercent)
 	c.String(status, "\n"+message)
 }
+
+// CacheCheck checks whether the informer caches of all clusters have synced.
+func CacheCheck(c *gin.Context) {
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		c.String(http.StatusInternalServerError, "\n"+err.Error())
+		return
+	}
+
+	status := http.StatusOK
+	text := "OK"
+	states := make([]string, 0)
+	for _, name := range registry.Names() {
+		m, err := registry.Get(name)
+		if err != nil {
+			continue
+		}
+
+		state := "disabled"
+		if enabled, synced := m.CacheStatus(); synced {
+			state = "synced"
+		} else if enabled {
+			state = "syncing"
+			status = http.StatusServiceUnavailable
+			text = "WARNING"
+		}
+		states = append(states, fmt.Sprintf("%s: %s", name, state))
+	}
+
+	message := fmt.Sprintf("%s - Cache: %s", text, strings.Join(states, ", "))
+	c.String(status, "\n"+message)
+}
+
+// DBCheck checks the database connection.
+func DBCheck(c *gin.Context) {
+	if err := model.DB.Ping(); err != nil {
+		c.String(http.StatusServiceUnavailable, "\nCRITICAL - Database: "+err.Error())
+		return
+	}
+
+	c.String(http.StatusOK, "\nOK - Database: "+model.DB.Self.Dialect().GetName())
+}
@@ -11,7 +11,7 @@ import (
 )
 
 // @Summary 创建 User 对象
-// @Description 创建 User 对象
+// @Description 创建 User 对象，只有管理员可以创建用户
 // @Tags user
 // @Accept json
 // @Produce json
@@ -29,6 +29,7 @@ func Create(c *gin.Context) {
 	u := model.UserModel{
 		Username: r.Username,
 		Password: r.Password,
+		Admin:    r.Admin,
 	}
 
 	// Validate the data.
@@ -36,6 +37,16 @@ func Create(c *gin.Context) {
 		tool.SendResponse(c, errno.ErrValidation, nil)
 		return
 	}
+	// The username must be unique.
+	exists, err := model.UserExists(u.Username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+	if exists {
+		tool.SendResponse(c, errno.ErrUserExists, nil)
+		return
+	}
 	// Encrypt the user password.
 	if err := u.Encrypt(); err != nil {
 		tool.SendResponse(c, errno.ErrEncrypt, nil)
@@ -0,0 +1,45 @@
+package user
+
+import (
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/lexkong/log/lager"
+)
+
+// @Summary 删除用户
+// @Description 删除用户并收回用户拥有的全部命名空间，仅管理员可调用
+// @Tags user
+// @Produce json
+// @Param username path string true "用户名"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username} [delete]
+func Delete(c *gin.Context) {
+	log.Debug("调用删除用户的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	username := c.Param("username")
+	if username == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	u, err := muser.GetUser(username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUserNotFound, nil)
+		return
+	}
+
+	if err := muser.DeleteAllUserNamespace(u.Username); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	if err := muser.DeleteUser(u.ID); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	tool.SendResponse(c, nil, nil)
+}
@@ -0,0 +1,82 @@
+package user
+
+import (
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/lexkong/log/lager"
+)
+
+// @Summary 获取用户信息
+// @Description 获取用户信息，普通用户只能获取自己的信息
+// @Tags user
+// @Produce json
+// @Param username path string true "用户名"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username} [get]
+func Get(c *gin.Context) {
+	log.Debug("调用获取用户信息的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	username := c.Param("username")
+	if username == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if !canAccess(c, username) {
+		tool.SendResponse(c, errno.ErrPermissionDenied, nil)
+		return
+	}
+
+	u, err := muser.GetUser(username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUserNotFound, nil)
+		return
+	}
+
+	tool.SendResponse(c, nil, userInfo(u))
+}
+
+// @Summary 获取当前登录用户的信息
+// @Description 获取当前登录用户的信息，包括是否为管理员和拥有的命名空间
+// @Tags user
+// @Produce json
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/me [get]
+func Current(c *gin.Context) {
+	log.Debug("调用获取当前用户信息的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrTokenInvalid, nil)
+		return
+	}
+
+	u, err := muser.GetUser(ctx.Username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUserNotFound, nil)
+		return
+	}
+
+	namespaces, err := muser.ListUserNamespace(u.Username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	rsp := CurrentResponse{
+		User:          userInfo(u),
+		Admin:         muser.IsAdmin(u.Username),
+		NamespaceList: make([]NamespaceInfo, 0, len(namespaces)),
+	}
+	for _, n := range namespaces {
+		rsp.NamespaceList = append(rsp.NamespaceList, NamespaceInfo{
+			Cluster:   n.Cluster,
+			Namespace: n.Namespace,
+		})
+	}
+
+	tool.SendResponse(c, nil, rsp)
+}
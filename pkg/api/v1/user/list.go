@@ -0,0 +1,51 @@
+package user
+
+import (
+	"hello-k8s/pkg/model"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/lexkong/log/lager"
+)
+
+// @Summary 获取用户列表
+// @Description 按用户名模糊查询用户列表，仅管理员可调用
+// @Tags user
+// @Produce json
+// @Param username query string false "用户名关键字"
+// @Param offset query int false "偏移量"
+// @Param limit query int false "返回的最大记录数"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{"totalCount":1,"userList":[]}}"
+// @Router /v1/user [get]
+func List(c *gin.Context) {
+	log.Debug("调用获取用户列表的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	var r ListRequest
+	if err := c.BindQuery(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, nil)
+		return
+	}
+
+	if r.Offset < 0 || r.Limit < 0 {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	users, count, err := muser.ListUser(r.Username, r.Offset, r.Limit)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	rsp := ListResponse{
+		TotalCount: count,
+		UserList:   make([]*model.UserInfo, 0, len(users)),
+	}
+	for _, u := range users {
+		rsp.UserList = append(rsp.UserList, userInfo(u))
+	}
+
+	tool.SendResponse(c, nil, rsp)
+}
@@ -0,0 +1,60 @@
+package user
+
+import (
+	"hello-k8s/pkg/model"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/auth"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/lexkong/log/lager"
+)
+
+// Compared with the password of unknown users, so that the login of an unknown
+// user takes as long as the login of an existing user.
+var dummyPassword, _ = auth.Encrypt("hello-k8s")
+
+// @Summary 用户登录
+// @Description 校验用户名和密码，成功后返回访问其他接口时所需的 token
+// @Tags user
+// @Accept json
+// @Produce json
+// @param data body LoginRequest true "用户名和密码"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{"token":""}}"
+// @Router /v1/login [post]
+func Login(c *gin.Context) {
+	log.Debug("调用用户登录的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	var r LoginRequest
+	if err := c.Bind(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, nil)
+		return
+	}
+
+	// Get the user information by the login username. Unknown users and
+	// wrong passwords get the same error, and the password is compared even
+	// for unknown users, so that the usernames can not be enumerated.
+	u, err := muser.GetUser(r.Username)
+	if err != nil {
+		auth.Compare(dummyPassword, r.Password)
+		tool.SendResponse(c, errno.ErrLoginFailed, nil)
+		return
+	}
+
+	// Compare the login password with the user password.
+	if err := u.Compare(r.Password); err != nil {
+		tool.SendResponse(c, errno.ErrLoginFailed, nil)
+		return
+	}
+
+	// Sign the token.
+	t, err := token.Sign(token.Context{Username: u.Username})
+	if err != nil {
+		tool.SendResponse(c, errno.ErrToken, nil)
+		return
+	}
+
+	tool.SendResponse(c, nil, model.Token{Token: t})
+}
@@ -0,0 +1,143 @@
+package user
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// @Summary 获取用户拥有的命名空间列表
+// @Description 获取用户拥有的命名空间列表，仅管理员可调用
+// @Tags user
+// @Produce json
+// @Param username path string true "用户名"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username}/namespace [get]
+func ListNamespace(c *gin.Context) {
+	log.Debug("调用获取用户命名空间列表的函数.")
+
+	username := c.Param("username")
+	if username == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	namespaces, err := muser.ListUserNamespace(username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	rsp := NamespaceListResponse{
+		TotalCount:    len(namespaces),
+		NamespaceList: make([]NamespaceInfo, 0, len(namespaces)),
+	}
+	for _, n := range namespaces {
+		rsp.NamespaceList = append(rsp.NamespaceList, NamespaceInfo{
+			Cluster:   n.Cluster,
+			Namespace: n.Namespace,
+		})
+	}
+
+	tool.SendResponse(c, errno.OK, rsp)
+}
+
+// @Summary 为用户分配命名空间
+// @Description 为用户分配命名空间，用户只能访问自己拥有的命名空间，仅管理员可调用
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param username path string true "用户名"
+// @param data body NamespaceRequest true "集群名称和命名空间"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username}/namespace [post]
+func AddNamespace(c *gin.Context) {
+	log.Debug("调用为用户分配命名空间的函数.")
+
+	username, r, ok := bindNamespaceRequest(c)
+	if !ok {
+		return
+	}
+
+	if _, err := muser.GetUser(username); err != nil {
+		tool.SendResponse(c, errno.ErrUserNotFound, nil)
+		return
+	}
+
+	n := muser.UserNamespaceModel{
+		Username:  username,
+		Cluster:   r.Cluster,
+		Namespace: r.Namespace,
+	}
+	if err := n.Create(); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
+
+// @Summary 收回用户的命名空间
+// @Description 收回用户的命名空间，仅管理员可调用
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param username path string true "用户名"
+// @param data body NamespaceRequest true "集群名称和命名空间"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username}/namespace [delete]
+func RemoveNamespace(c *gin.Context) {
+	log.Debug("调用收回用户命名空间的函数.")
+
+	username, r, ok := bindNamespaceRequest(c)
+	if !ok {
+		return
+	}
+
+	if err := muser.DeleteUserNamespace(username, r.Cluster, r.Namespace); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	tool.SendResponse(c, errno.OK, nil)
+}
+
+// Binds the username and the namespace request, the cluster name defaults to
+// the default cluster. The error response is sent if ok is false.
+func bindNamespaceRequest(c *gin.Context) (username string, r NamespaceRequest, ok bool) {
+	username = c.Param("username")
+	if username == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if err := c.BindJSON(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, err)
+		return
+	}
+
+	if r.Namespace == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		tool.SendResponse(c, errno.InternalServerError, nil)
+		return
+	}
+
+	if r.Cluster == "" {
+		r.Cluster = registry.Default()
+	}
+	if _, err := registry.Get(r.Cluster); err != nil {
+		tool.SendResponse(c, errno.ErrClusterNotFound, nil)
+		return
+	}
+
+	return username, r, true
+}
@@ -0,0 +1,66 @@
+package user
+
+import (
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/tool"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/lexkong/log/lager"
+)
+
+// @Summary 更新用户信息
+// @Description 更新用户密码，普通用户只能更新自己的信息
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param username path string true "用户名"
+// @param data body UpdateRequest true "更新用户信息时所需参数"
+// @Success 200 {object} tool.Response "{"code":0,"message":"OK","data":{""}}"
+// @Router /v1/user/{username} [put]
+func Update(c *gin.Context) {
+	log.Debug("调用更新用户信息的接口！", lager.Data{"X-Request-Id": tool.GetReqID(c)})
+	username := c.Param("username")
+	if username == "" {
+		tool.SendResponse(c, errno.ErrBadParam, nil)
+		return
+	}
+
+	if !canAccess(c, username) {
+		tool.SendResponse(c, errno.ErrPermissionDenied, nil)
+		return
+	}
+
+	var r UpdateRequest
+	if err := c.Bind(&r); err != nil {
+		tool.SendResponse(c, errno.ErrBind, nil)
+		return
+	}
+
+	u, err := muser.GetUser(username)
+	if err != nil {
+		tool.SendResponse(c, errno.ErrUserNotFound, nil)
+		return
+	}
+
+	u.Password = r.Password
+
+	// Validate the data.
+	if err := u.Validate(); err != nil {
+		tool.SendResponse(c, errno.ErrValidation, nil)
+		return
+	}
+	// Encrypt the user password.
+	if err := u.Encrypt(); err != nil {
+		tool.SendResponse(c, errno.ErrEncrypt, nil)
+		return
+	}
+	// Save changed fields.
+	if err := u.Update(); err != nil {
+		tool.SendResponse(c, errno.ErrDatabase, nil)
+		return
+	}
+
+	tool.SendResponse(c, nil, userInfo(u))
+}
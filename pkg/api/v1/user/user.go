@@ -5,6 +5,9 @@ import "hello-k8s/pkg/model"
 type CreateRequest struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
+
+	// Admin 是否为管理员.
+	Admin bool `json:"admin"`
 }
 
 type CreateResponse struct {
@@ -12,12 +15,59 @@ type CreateResponse struct {
 }
 
 type ListRequest struct {
-	Username string `json:"username"`
-	Offset   int    `json:"offset"`
-	Limit    int    `json:"limit"`
+	Username string `json:"username" form:"username"`
+	Offset   int    `json:"offset" form:"offset"`
+	Limit    int    `json:"limit" form:"limit"`
 }
 
 type ListResponse struct {
 	TotalCount uint64            `json:"totalCount"`
 	UserList   []*model.UserInfo `json:"userList"`
 }
+
+// UpdateRequest 定义了更新用户信息时所需参数.
+type UpdateRequest struct {
+	// Password 新密码.
+	Password string `json:"password"`
+}
+
+// CurrentResponse 定义了当前登录用户的信息.
+type CurrentResponse struct {
+	User *model.UserInfo `json:"user"`
+
+	// Admin 是否为管理员.
+	Admin bool `json:"admin"`
+
+	// NamespaceList 用户拥有的命名空间.
+	NamespaceList []NamespaceInfo `json:"namespaceList"`
+}
+
+// LoginRequest 定义了用户登录时所需参数.
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// NamespaceRequest 定义了为用户分配或收回命名空间时所需参数.
+type NamespaceRequest struct {
+	// Cluster 集群名称，为空时使用默认集群.
+	Cluster string `json:"cluster"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+}
+
+// NamespaceInfo 定义了用户拥有的一个命名空间.
+type NamespaceInfo struct {
+	// Cluster 集群名称.
+	Cluster string `json:"cluster"`
+
+	// Namespace 命名空间.
+	Namespace string `json:"namespace"`
+}
+
+// NamespaceListResponse 定义了用户命名空间列表的返回结果.
+type NamespaceListResponse struct {
+	TotalCount    int             `json:"totalCount"`
+	NamespaceList []NamespaceInfo `json:"namespaceList"`
+}
@@ -0,0 +1,32 @@
+package user
+
+import (
+	"hello-k8s/pkg/model"
+	muser "hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/token"
+
+	"github.com/gin-gonic/gin"
+)
+
+const timeFormat = "2006-01-02 15:04:05"
+
+// Converts the user model to the user information returned by the API, the
+// password is never returned.
+func userInfo(u *muser.UserModel) *model.UserInfo {
+	return &model.UserInfo{
+		ID:        u.ID,
+		UserName:  u.Username,
+		CreatedAt: u.CreatedAt.Format(timeFormat),
+		UpdateAt:  u.UpdatedAt.Format(timeFormat),
+	}
+}
+
+// Returns true if the current user is an administrator or the given user.
+func canAccess(c *gin.Context, username string) bool {
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		return false
+	}
+
+	return ctx.Username == username || muser.IsAdmin(ctx.Username)
+}
@@ -0,0 +1,102 @@
+// Package cache provides an informer backed read cache for a kubernetes cluster. The cached
+// client returned by Cache.Client serves List and Get calls of the most frequently read
+// resources from local listers and delegates every other call to the live client, so it can
+// be passed to the kuberesource packages in place of the live client.
+package cache
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lexkong/log"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+// Cache keeps informer backed copies of the resources of a cluster.
+type Cache struct {
+	name     string
+	client   kubernetes.Interface
+	factory  informers.SharedInformerFactory
+	cached   kubernetes.Interface
+	synced   []toolscache.InformerSynced
+	ready    int32
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// New creates a cache for the cluster called name. Informers are not started until Start is
+// called.
+func New(name string, client kubernetes.Interface, resync time.Duration) *Cache {
+	factory := informers.NewSharedInformerFactory(client, resync)
+	c := &Cache{
+		name:    name,
+		client:  client,
+		factory: factory,
+		stopCh:  make(chan struct{}),
+	}
+	c.cached = &clientset{
+		Interface: client,
+		factory:   factory,
+	}
+
+	for _, informer := range []toolscache.SharedIndexInformer{
+		factory.Core().V1().Pods().Informer(),
+		factory.Core().V1().Events().Informer(),
+		factory.Core().V1().Services().Informer(),
+		factory.Core().V1().Endpoints().Informer(),
+		factory.Core().V1().ConfigMaps().Informer(),
+		factory.Core().V1().Secrets().Informer(),
+		factory.Core().V1().PersistentVolumeClaims().Informer(),
+		factory.Core().V1().ReplicationControllers().Informer(),
+		factory.Core().V1().Namespaces().Informer(),
+		factory.Core().V1().Nodes().Informer(),
+		factory.Apps().V1().Deployments().Informer(),
+		factory.Apps().V1().ReplicaSets().Informer(),
+		factory.Apps().V1().StatefulSets().Informer(),
+		factory.Apps().V1().DaemonSets().Informer(),
+		factory.Batch().V1().Jobs().Informer(),
+		factory.Batch().V1beta1().CronJobs().Informer(),
+		factory.Storage().V1().StorageClasses().Informer(),
+	} {
+		c.synced = append(c.synced, informer.HasSynced)
+	}
+
+	return c
+}
+
+// Start starts the informers and marks the cache as synced once all of them have finished
+// the initial list.
+func (c *Cache) Start() {
+	c.factory.Start(c.stopCh)
+
+	go func() {
+		start := time.Now()
+		if !toolscache.WaitForCacheSync(c.stopCh, c.synced...) {
+			log.Infof("Cache of cluster %q stopped before it was synced", c.name)
+			return
+		}
+
+		atomic.StoreInt32(&c.ready, 1)
+		log.Infof("Cache of cluster %q synced in %s", c.name, time.Since(start))
+	}()
+}
+
+// Stop stops the informers.
+func (c *Cache) Stop() {
+	c.stopOnce.Do(func() {
+		close(c.stopCh)
+	})
+}
+
+// HasSynced returns true once all informers have finished the initial list.
+func (c *Cache) HasSynced() bool {
+	return atomic.LoadInt32(&c.ready) == 1
+}
+
+// Client returns a client that serves reads of the cached resources from the informers.
+func (c *Cache) Client() kubernetes.Interface {
+	return c.cached
+}
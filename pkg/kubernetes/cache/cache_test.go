@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lexkong/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func init() {
+	log.InitWithConfig(&log.PassLagerCfg{
+		Writers:     "stdout",
+		LoggerLevel: "ERROR",
+		LoggerFile:  filepath.Join(os.TempDir(), "hello-k8s-test.log"),
+	})
+}
+
+func newSyncedCache(t *testing.T, objects ...*v1.Pod) *Cache {
+	client := fake.NewSimpleClientset()
+	for _, pod := range objects {
+		if _, err := client.CoreV1().Pods(pod.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c := New("test", client, 0)
+	c.Start()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for !c.HasSynced() {
+		if time.Now().After(deadline) {
+			t.Fatal("cache did not sync in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return c
+}
+
+func TestCachedPodsList(t *testing.T) {
+	pods := []*v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns-1", Labels: map[string]string{"app": "foo"}},
+			Spec:       v1.PodSpec{NodeName: "node-1"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "ns-1", Labels: map[string]string{"app": "bar"}},
+			Spec:       v1.PodSpec{NodeName: "node-2"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "c", Namespace: "ns-2", Labels: map[string]string{"app": "foo"}},
+			Spec:       v1.PodSpec{NodeName: "node-1"},
+		},
+	}
+	c := newSyncedCache(t, pods...)
+	defer c.Stop()
+
+	cases := []struct {
+		namespace string
+		opts      metav1.ListOptions
+		expected  []string
+	}{
+		{"ns-1", metav1.ListOptions{}, []string{"a", "b"}},
+		{"", metav1.ListOptions{LabelSelector: "app=foo"}, []string{"a", "c"}},
+		{"", metav1.ListOptions{FieldSelector: "spec.nodeName=node-1"}, []string{"a", "c"}},
+		{"ns-2", metav1.ListOptions{FieldSelector: "spec.nodeName=node-2"}, []string{}},
+	}
+
+	for _, tc := range cases {
+		list, err := c.Client().CoreV1().Pods(tc.namespace).List(context.TODO(), tc.opts)
+		if err != nil {
+			t.Fatalf("List(%q, %#v) returned error: %v", tc.namespace, tc.opts, err)
+		}
+
+		actual := make(map[string]bool)
+		for _, pod := range list.Items {
+			actual[pod.Name] = true
+		}
+		expected := make(map[string]bool)
+		for _, name := range tc.expected {
+			expected[name] = true
+		}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("List(%q, %#v) == %v, expected %v", tc.namespace, tc.opts, actual, expected)
+		}
+	}
+}
+
+func TestCachedPodsGet(t *testing.T) {
+	c := newSyncedCache(t, &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "ns-1"}})
+	defer c.Stop()
+
+	pod, err := c.Client().CoreV1().Pods("ns-1").Get(context.TODO(), "a", metav1.GetOptions{})
+	if err != nil || pod.Name != "a" {
+		t.Errorf("Get(ns-1, a) == %v, %v, expected pod a", pod, err)
+	}
+
+	pod.Labels = map[string]string{"mutated": "true"}
+	cached, _ := c.Client().CoreV1().Pods("ns-1").Get(context.TODO(), "a", metav1.GetOptions{})
+	if len(cached.Labels) != 0 {
+		t.Errorf("Get returned an object shared with the cache")
+	}
+
+	if _, err := c.Client().CoreV1().Pods("ns-2").Get(context.TODO(), "a", metav1.GetOptions{}); !errors.IsNotFound(err) {
+		t.Errorf("Get(ns-2, a) error == %v, expected not found", err)
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	supported := podFields(&v1.Pod{})
+	cases := []struct {
+		opts     metav1.ListOptions
+		expected bool
+	}{
+		{metav1.ListOptions{}, true},
+		{metav1.ListOptions{LabelSelector: "app in (foo,bar)"}, true},
+		{metav1.ListOptions{FieldSelector: "status.phase!=Failed"}, true},
+		{metav1.ListOptions{FieldSelector: "spec.unknown=foo"}, false},
+		{metav1.ListOptions{Limit: 10}, false},
+		{metav1.ListOptions{ResourceVersion: "0"}, false},
+	}
+
+	for _, c := range cases {
+		if _, _, ok := selectors(c.opts, supported); ok != c.expected {
+			t.Errorf("selectors(%#v) ok == %v, expected %v", c.opts, ok, c.expected)
+		}
+	}
+}
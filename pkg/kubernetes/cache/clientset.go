@@ -0,0 +1,753 @@
+package cache
+
+import (
+	"context"
+
+	apps "k8s.io/api/apps/v1"
+	batch "k8s.io/api/batch/v1"
+	batch2 "k8s.io/api/batch/v1beta1"
+	v1 "k8s.io/api/core/v1"
+	storage "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	appsv1client "k8s.io/client-go/kubernetes/typed/apps/v1"
+	batchv1client "k8s.io/client-go/kubernetes/typed/batch/v1"
+	batchv1beta1client "k8s.io/client-go/kubernetes/typed/batch/v1beta1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	storagev1client "k8s.io/client-go/kubernetes/typed/storage/v1"
+	appsv1listers "k8s.io/client-go/listers/apps/v1"
+	batchv1listers "k8s.io/client-go/listers/batch/v1"
+	batchv1beta1listers "k8s.io/client-go/listers/batch/v1beta1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	storagev1listers "k8s.io/client-go/listers/storage/v1"
+)
+
+// clientset serves reads of the cached resources from the informers of factory and
+// delegates everything else to the embedded live client.
+type clientset struct {
+	kubernetes.Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *clientset) CoreV1() corev1client.CoreV1Interface {
+	return &coreV1{c.Interface.CoreV1(), c.factory}
+}
+
+type coreV1 struct {
+	corev1client.CoreV1Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *coreV1) Pods(namespace string) corev1client.PodInterface {
+	return &cachedPods{c.CoreV1Interface.Pods(namespace), c.factory.Core().V1().Pods().Lister(), namespace}
+}
+
+type cachedPods struct {
+	corev1client.PodInterface
+	lister    corev1listers.PodLister
+	namespace string
+}
+
+func (c *cachedPods) List(ctx context.Context, opts metav1.ListOptions) (*v1.PodList, error) {
+	label, field, ok := selectors(opts, podFields(&v1.Pod{}))
+	if !ok {
+		return c.PodInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Pods(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.PodList{Items: make([]v1.Pod, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(podFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedPods) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Pod, error) {
+	item, err := c.lister.Pods(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Events(namespace string) corev1client.EventInterface {
+	return &cachedEvents{c.CoreV1Interface.Events(namespace), c.factory.Core().V1().Events().Lister(), namespace}
+}
+
+type cachedEvents struct {
+	corev1client.EventInterface
+	lister    corev1listers.EventLister
+	namespace string
+}
+
+func (c *cachedEvents) List(ctx context.Context, opts metav1.ListOptions) (*v1.EventList, error) {
+	label, field, ok := selectors(opts, eventFields(&v1.Event{}))
+	if !ok {
+		return c.EventInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Events(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.EventList{Items: make([]v1.Event, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(eventFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedEvents) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Event, error) {
+	item, err := c.lister.Events(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Services(namespace string) corev1client.ServiceInterface {
+	return &cachedServices{c.CoreV1Interface.Services(namespace), c.factory.Core().V1().Services().Lister(), namespace}
+}
+
+type cachedServices struct {
+	corev1client.ServiceInterface
+	lister    corev1listers.ServiceLister
+	namespace string
+}
+
+func (c *cachedServices) List(ctx context.Context, opts metav1.ListOptions) (*v1.ServiceList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.Service{}))
+	if !ok {
+		return c.ServiceInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Services(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.ServiceList{Items: make([]v1.Service, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedServices) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Service, error) {
+	item, err := c.lister.Services(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Endpoints(namespace string) corev1client.EndpointsInterface {
+	return &cachedEndpoints{c.CoreV1Interface.Endpoints(namespace), c.factory.Core().V1().Endpoints().Lister(), namespace}
+}
+
+type cachedEndpoints struct {
+	corev1client.EndpointsInterface
+	lister    corev1listers.EndpointsLister
+	namespace string
+}
+
+func (c *cachedEndpoints) List(ctx context.Context, opts metav1.ListOptions) (*v1.EndpointsList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.Endpoints{}))
+	if !ok {
+		return c.EndpointsInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Endpoints(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.EndpointsList{Items: make([]v1.Endpoints, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedEndpoints) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Endpoints, error) {
+	item, err := c.lister.Endpoints(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) ConfigMaps(namespace string) corev1client.ConfigMapInterface {
+	return &cachedConfigMaps{c.CoreV1Interface.ConfigMaps(namespace), c.factory.Core().V1().ConfigMaps().Lister(), namespace}
+}
+
+type cachedConfigMaps struct {
+	corev1client.ConfigMapInterface
+	lister    corev1listers.ConfigMapLister
+	namespace string
+}
+
+func (c *cachedConfigMaps) List(ctx context.Context, opts metav1.ListOptions) (*v1.ConfigMapList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.ConfigMap{}))
+	if !ok {
+		return c.ConfigMapInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.ConfigMaps(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.ConfigMapList{Items: make([]v1.ConfigMap, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedConfigMaps) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ConfigMap, error) {
+	item, err := c.lister.ConfigMaps(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Secrets(namespace string) corev1client.SecretInterface {
+	return &cachedSecrets{c.CoreV1Interface.Secrets(namespace), c.factory.Core().V1().Secrets().Lister(), namespace}
+}
+
+type cachedSecrets struct {
+	corev1client.SecretInterface
+	lister    corev1listers.SecretLister
+	namespace string
+}
+
+func (c *cachedSecrets) List(ctx context.Context, opts metav1.ListOptions) (*v1.SecretList, error) {
+	label, field, ok := selectors(opts, secretFields(&v1.Secret{}))
+	if !ok {
+		return c.SecretInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Secrets(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.SecretList{Items: make([]v1.Secret, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(secretFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedSecrets) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Secret, error) {
+	item, err := c.lister.Secrets(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) PersistentVolumeClaims(namespace string) corev1client.PersistentVolumeClaimInterface {
+	return &cachedPersistentVolumeClaims{c.CoreV1Interface.PersistentVolumeClaims(namespace), c.factory.Core().V1().PersistentVolumeClaims().Lister(), namespace}
+}
+
+type cachedPersistentVolumeClaims struct {
+	corev1client.PersistentVolumeClaimInterface
+	lister    corev1listers.PersistentVolumeClaimLister
+	namespace string
+}
+
+func (c *cachedPersistentVolumeClaims) List(ctx context.Context, opts metav1.ListOptions) (*v1.PersistentVolumeClaimList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.PersistentVolumeClaim{}))
+	if !ok {
+		return c.PersistentVolumeClaimInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.PersistentVolumeClaims(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.PersistentVolumeClaimList{Items: make([]v1.PersistentVolumeClaim, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedPersistentVolumeClaims) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.PersistentVolumeClaim, error) {
+	item, err := c.lister.PersistentVolumeClaims(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) ReplicationControllers(namespace string) corev1client.ReplicationControllerInterface {
+	return &cachedReplicationControllers{c.CoreV1Interface.ReplicationControllers(namespace), c.factory.Core().V1().ReplicationControllers().Lister(), namespace}
+}
+
+type cachedReplicationControllers struct {
+	corev1client.ReplicationControllerInterface
+	lister    corev1listers.ReplicationControllerLister
+	namespace string
+}
+
+func (c *cachedReplicationControllers) List(ctx context.Context, opts metav1.ListOptions) (*v1.ReplicationControllerList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.ReplicationController{}))
+	if !ok {
+		return c.ReplicationControllerInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.ReplicationControllers(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.ReplicationControllerList{Items: make([]v1.ReplicationController, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedReplicationControllers) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.ReplicationController, error) {
+	item, err := c.lister.ReplicationControllers(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Namespaces() corev1client.NamespaceInterface {
+	return &cachedNamespaces{c.CoreV1Interface.Namespaces(), c.factory.Core().V1().Namespaces().Lister()}
+}
+
+type cachedNamespaces struct {
+	corev1client.NamespaceInterface
+	lister corev1listers.NamespaceLister
+}
+
+func (c *cachedNamespaces) List(ctx context.Context, opts metav1.ListOptions) (*v1.NamespaceList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.Namespace{}))
+	if !ok {
+		return c.NamespaceInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.NamespaceList{Items: make([]v1.Namespace, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedNamespaces) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Namespace, error) {
+	item, err := c.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *coreV1) Nodes() corev1client.NodeInterface {
+	return &cachedNodes{c.CoreV1Interface.Nodes(), c.factory.Core().V1().Nodes().Lister()}
+}
+
+type cachedNodes struct {
+	corev1client.NodeInterface
+	lister corev1listers.NodeLister
+}
+
+func (c *cachedNodes) List(ctx context.Context, opts metav1.ListOptions) (*v1.NodeList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&v1.Node{}))
+	if !ok {
+		return c.NodeInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &v1.NodeList{Items: make([]v1.Node, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedNodes) Get(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Node, error) {
+	item, err := c.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *clientset) AppsV1() appsv1client.AppsV1Interface {
+	return &appsV1{c.Interface.AppsV1(), c.factory}
+}
+
+type appsV1 struct {
+	appsv1client.AppsV1Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *appsV1) Deployments(namespace string) appsv1client.DeploymentInterface {
+	return &cachedDeployments{c.AppsV1Interface.Deployments(namespace), c.factory.Apps().V1().Deployments().Lister(), namespace}
+}
+
+type cachedDeployments struct {
+	appsv1client.DeploymentInterface
+	lister    appsv1listers.DeploymentLister
+	namespace string
+}
+
+func (c *cachedDeployments) List(ctx context.Context, opts metav1.ListOptions) (*apps.DeploymentList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&apps.Deployment{}))
+	if !ok {
+		return c.DeploymentInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Deployments(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &apps.DeploymentList{Items: make([]apps.Deployment, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedDeployments) Get(ctx context.Context, name string, opts metav1.GetOptions) (*apps.Deployment, error) {
+	item, err := c.lister.Deployments(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *appsV1) ReplicaSets(namespace string) appsv1client.ReplicaSetInterface {
+	return &cachedReplicaSets{c.AppsV1Interface.ReplicaSets(namespace), c.factory.Apps().V1().ReplicaSets().Lister(), namespace}
+}
+
+type cachedReplicaSets struct {
+	appsv1client.ReplicaSetInterface
+	lister    appsv1listers.ReplicaSetLister
+	namespace string
+}
+
+func (c *cachedReplicaSets) List(ctx context.Context, opts metav1.ListOptions) (*apps.ReplicaSetList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&apps.ReplicaSet{}))
+	if !ok {
+		return c.ReplicaSetInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.ReplicaSets(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &apps.ReplicaSetList{Items: make([]apps.ReplicaSet, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedReplicaSets) Get(ctx context.Context, name string, opts metav1.GetOptions) (*apps.ReplicaSet, error) {
+	item, err := c.lister.ReplicaSets(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *appsV1) StatefulSets(namespace string) appsv1client.StatefulSetInterface {
+	return &cachedStatefulSets{c.AppsV1Interface.StatefulSets(namespace), c.factory.Apps().V1().StatefulSets().Lister(), namespace}
+}
+
+type cachedStatefulSets struct {
+	appsv1client.StatefulSetInterface
+	lister    appsv1listers.StatefulSetLister
+	namespace string
+}
+
+func (c *cachedStatefulSets) List(ctx context.Context, opts metav1.ListOptions) (*apps.StatefulSetList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&apps.StatefulSet{}))
+	if !ok {
+		return c.StatefulSetInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.StatefulSets(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &apps.StatefulSetList{Items: make([]apps.StatefulSet, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedStatefulSets) Get(ctx context.Context, name string, opts metav1.GetOptions) (*apps.StatefulSet, error) {
+	item, err := c.lister.StatefulSets(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *appsV1) DaemonSets(namespace string) appsv1client.DaemonSetInterface {
+	return &cachedDaemonSets{c.AppsV1Interface.DaemonSets(namespace), c.factory.Apps().V1().DaemonSets().Lister(), namespace}
+}
+
+type cachedDaemonSets struct {
+	appsv1client.DaemonSetInterface
+	lister    appsv1listers.DaemonSetLister
+	namespace string
+}
+
+func (c *cachedDaemonSets) List(ctx context.Context, opts metav1.ListOptions) (*apps.DaemonSetList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&apps.DaemonSet{}))
+	if !ok {
+		return c.DaemonSetInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.DaemonSets(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &apps.DaemonSetList{Items: make([]apps.DaemonSet, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedDaemonSets) Get(ctx context.Context, name string, opts metav1.GetOptions) (*apps.DaemonSet, error) {
+	item, err := c.lister.DaemonSets(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *clientset) BatchV1() batchv1client.BatchV1Interface {
+	return &batchV1{c.Interface.BatchV1(), c.factory}
+}
+
+type batchV1 struct {
+	batchv1client.BatchV1Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *batchV1) Jobs(namespace string) batchv1client.JobInterface {
+	return &cachedJobs{c.BatchV1Interface.Jobs(namespace), c.factory.Batch().V1().Jobs().Lister(), namespace}
+}
+
+type cachedJobs struct {
+	batchv1client.JobInterface
+	lister    batchv1listers.JobLister
+	namespace string
+}
+
+func (c *cachedJobs) List(ctx context.Context, opts metav1.ListOptions) (*batch.JobList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&batch.Job{}))
+	if !ok {
+		return c.JobInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.Jobs(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &batch.JobList{Items: make([]batch.Job, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedJobs) Get(ctx context.Context, name string, opts metav1.GetOptions) (*batch.Job, error) {
+	item, err := c.lister.Jobs(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *clientset) BatchV1beta1() batchv1beta1client.BatchV1beta1Interface {
+	return &batchV1beta1{c.Interface.BatchV1beta1(), c.factory}
+}
+
+type batchV1beta1 struct {
+	batchv1beta1client.BatchV1beta1Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *batchV1beta1) CronJobs(namespace string) batchv1beta1client.CronJobInterface {
+	return &cachedCronJobs{c.BatchV1beta1Interface.CronJobs(namespace), c.factory.Batch().V1beta1().CronJobs().Lister(), namespace}
+}
+
+type cachedCronJobs struct {
+	batchv1beta1client.CronJobInterface
+	lister    batchv1beta1listers.CronJobLister
+	namespace string
+}
+
+func (c *cachedCronJobs) List(ctx context.Context, opts metav1.ListOptions) (*batch2.CronJobList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&batch2.CronJob{}))
+	if !ok {
+		return c.CronJobInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.CronJobs(c.namespace).List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &batch2.CronJobList{Items: make([]batch2.CronJob, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedCronJobs) Get(ctx context.Context, name string, opts metav1.GetOptions) (*batch2.CronJob, error) {
+	item, err := c.lister.CronJobs(c.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
+
+func (c *clientset) StorageV1() storagev1client.StorageV1Interface {
+	return &storageV1{c.Interface.StorageV1(), c.factory}
+}
+
+type storageV1 struct {
+	storagev1client.StorageV1Interface
+	factory informers.SharedInformerFactory
+}
+
+func (c *storageV1) StorageClasses() storagev1client.StorageClassInterface {
+	return &cachedStorageClasses{c.StorageV1Interface.StorageClasses(), c.factory.Storage().V1().StorageClasses().Lister()}
+}
+
+type cachedStorageClasses struct {
+	storagev1client.StorageClassInterface
+	lister storagev1listers.StorageClassLister
+}
+
+func (c *cachedStorageClasses) List(ctx context.Context, opts metav1.ListOptions) (*storage.StorageClassList, error) {
+	label, field, ok := selectors(opts, objectMetaFields(&storage.StorageClass{}))
+	if !ok {
+		return c.StorageClassInterface.List(ctx, opts)
+	}
+
+	items, err := c.lister.List(label)
+	if err != nil {
+		return nil, err
+	}
+
+	list := &storage.StorageClassList{Items: make([]storage.StorageClass, 0, len(items))}
+	for _, item := range items {
+		if field.Matches(objectMetaFields(item)) {
+			list.Items = append(list.Items, *item.DeepCopy())
+		}
+	}
+
+	return list, nil
+}
+
+func (c *cachedStorageClasses) Get(ctx context.Context, name string, opts metav1.GetOptions) (*storage.StorageClass, error) {
+	item, err := c.lister.Get(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.DeepCopy(), nil
+}
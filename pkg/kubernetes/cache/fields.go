@@ -0,0 +1,75 @@
+package cache
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// selectors parses the label and field selectors of opts. ok is false when the call can not
+// be served from the cache, i.e. when it is paginated, asks for a specific resource version
+// or selects on a field that is not present in supported.
+func selectors(opts metav1.ListOptions, supported fields.Set) (label labels.Selector, field fields.Selector, ok bool) {
+	if opts.Limit > 0 || len(opts.Continue) > 0 || len(opts.ResourceVersion) > 0 {
+		return nil, nil, false
+	}
+
+	label, err := labels.Parse(opts.LabelSelector)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	field, err = fields.ParseSelector(opts.FieldSelector)
+	if err != nil {
+		return nil, nil, false
+	}
+
+	for _, requirement := range field.Requirements() {
+		if !supported.Has(requirement.Field) {
+			return nil, nil, false
+		}
+	}
+
+	return label, field, true
+}
+
+func objectMetaFields(meta metav1.Object) fields.Set {
+	return fields.Set{
+		"metadata.name":      meta.GetName(),
+		"metadata.namespace": meta.GetNamespace(),
+	}
+}
+
+func podFields(pod *v1.Pod) fields.Set {
+	set := objectMetaFields(pod)
+	set["spec.nodeName"] = pod.Spec.NodeName
+	set["spec.restartPolicy"] = string(pod.Spec.RestartPolicy)
+	set["spec.schedulerName"] = pod.Spec.SchedulerName
+	set["spec.serviceAccountName"] = pod.Spec.ServiceAccountName
+	set["status.phase"] = string(pod.Status.Phase)
+	set["status.podIP"] = pod.Status.PodIP
+	set["status.nominatedNodeName"] = pod.Status.NominatedNodeName
+	return set
+}
+
+func eventFields(event *v1.Event) fields.Set {
+	set := objectMetaFields(event)
+	set["involvedObject.kind"] = event.InvolvedObject.Kind
+	set["involvedObject.namespace"] = event.InvolvedObject.Namespace
+	set["involvedObject.name"] = event.InvolvedObject.Name
+	set["involvedObject.uid"] = string(event.InvolvedObject.UID)
+	set["involvedObject.apiVersion"] = event.InvolvedObject.APIVersion
+	set["involvedObject.resourceVersion"] = event.InvolvedObject.ResourceVersion
+	set["involvedObject.fieldPath"] = event.InvolvedObject.FieldPath
+	set["reason"] = event.Reason
+	set["source"] = event.Source.Component
+	set["type"] = event.Type
+	return set
+}
+
+func secretFields(secret *v1.Secret) fields.Set {
+	set := objectMetaFields(secret)
+	set["type"] = string(secret.Type)
+	return set
+}
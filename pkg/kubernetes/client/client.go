@@ -1,58 +1,317 @@
 package client
 
 import (
+	"errors"
+	"hello-k8s/pkg/kubernetes/cache"
+	kclient "hello-k8s/pkg/kubernetes/kuberesource/client"
+	clientapi "hello-k8s/pkg/kubernetes/kuberesource/client/api"
 	"path/filepath"
+	"sync"
+	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/lexkong/log"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	"k8s.io/client-go/util/homedir"
 )
 
-func New() (kubernetes.Interface, error) {
-	config, err := getKubernetesConfig()
-	if err != nil {
-		return nil, err
+const (
+	// DefaultQPS 未在配置文件中指定 qps 时使用的默认值.
+	DefaultQPS = 50
+	// DefaultBurst 未在配置文件中指定 burst 时使用的默认值.
+	DefaultBurst = 100
+	// ContextKey 是 Kubernetes 客户端在 gin.Context 中的键名.
+	ContextKey = "KubernetesClient"
+	// ClusterContextKey 是当前请求所访问集群的名称在 gin.Context 中的键名.
+	ClusterContextKey = "KubernetesClusterName"
+	// ConfigContextKey 是 Kubernetes 客户端 rest 配置在 gin.Context 中的键名.
+	ConfigContextKey = "KubernetesConfig"
+	// ManagerContextKey 是当前请求所访问集群的客户端管理器在 gin.Context 中的键名.
+	ManagerContextKey = "KubernetesManager"
+)
+
+// ClusterConfig 定义了连接一个 Kubernetes 集群时所需的参数.
+type ClusterConfig struct {
+	// Name 集群名称.
+	Name string `json:"name" mapstructure:"name"`
+
+	// KubeConfigPath kubeconfig 文件路径.
+	KubeConfigPath string `json:"kubeconfigPath" mapstructure:"kubeconfig"`
+
+	// KubeConfig kubeconfig 文件内容，优先于 KubeConfigPath.
+	KubeConfig string `json:"kubeconfig" mapstructure:"kubeconfig_content"`
+
+	// Context 使用的 kubeconfig context，为空时使用 current-context.
+	Context string `json:"context" mapstructure:"context"`
+
+	// APIServerHost apiserver 地址，格式为 'protocol://address:port'.
+	APIServerHost string `json:"apiserverHost" mapstructure:"apiserver_host"`
+
+	// QPS 客户端限流参数.
+	QPS float32 `json:"qps" mapstructure:"qps"`
+
+	// Burst 客户端限流参数.
+	Burst int `json:"burst" mapstructure:"burst"`
+}
+
+// Manager 管理在服务启动时创建、并在所有请求之间共享的 Kubernetes 客户端.
+type Manager struct {
+	cluster ClusterConfig
+
+	config              *rest.Config
+	client              kubernetes.Interface
+	apiExtensionsClient apiextensionsclientset.Interface
+	dynamicClient       dynamic.Interface
+	// Discovery client that caches the groups and resources served by the cluster.
+	discoveryClient discovery.CachedDiscoveryInterface
+	// Mapper backed by discoveryClient, the cache is refreshed when a kind is not found.
+	mapper meta.RESTMapper
+	// Informer backed read cache, nil when caching is disabled.
+	cache *cache.Cache
+
+	verberLock sync.Mutex
+	// Created on first use because the version of the CRD API has to be discovered.
+	verber clientapi.ResourceVerber
+}
+
+// NewManager 根据集群配置创建客户端管理器. 如果既没有指定 kubeconfig 也没有指定
+// apiserver 地址，则优先使用 in-cluster 配置，其次使用 ~/.kube/config.
+func NewManager(cluster ClusterConfig) (*Manager, error) {
+	if cluster.QPS <= 0 {
+		cluster.QPS = DefaultQPS
+	}
+	if cluster.Burst <= 0 {
+		cluster.Burst = DefaultBurst
 	}
 
-	client, err := kubernetes.NewForConfig(config)
-	if err != nil {
+	m := &Manager{
+		cluster: cluster,
+	}
+
+	if err := m.init(); err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	return m, nil
+}
+
+// Client 返回共享的 Kubernetes 客户端. 启用缓存且缓存同步完成后，返回的客户端
+// 从本地缓存中读取数据，否则直接访问 apiserver.
+func (m *Manager) Client() kubernetes.Interface {
+	if m.cache != nil && m.cache.HasSynced() {
+		return m.cache.Client()
+	}
+
+	return m.client
+}
+
+// LiveClient 返回直接访问 apiserver 的 Kubernetes 客户端.
+func (m *Manager) LiveClient() kubernetes.Interface {
+	return m.client
+}
+
+// EnableCache 为集群启动基于 informer 的读缓存.
+func (m *Manager) EnableCache(resync time.Duration) {
+	if m.cache != nil {
+		return
+	}
+
+	m.cache = cache.New(m.cluster.Name, m.client, resync)
+	m.cache.Start()
+}
+
+// CacheStatus 返回集群是否启用了缓存以及缓存是否同步完成.
+func (m *Manager) CacheStatus() (enabled, synced bool) {
+	if m.cache == nil {
+		return false, false
+	}
+
+	return true, m.cache.HasSynced()
+}
+
+// Close 释放客户端管理器持有的资源.
+func (m *Manager) Close() {
+	if m.cache != nil {
+		m.cache.Stop()
+	}
+}
+
+// APIExtensionsClient 返回共享的 API Extensions 客户端.
+func (m *Manager) APIExtensionsClient() apiextensionsclientset.Interface {
+	return m.apiExtensionsClient
+}
+
+// DynamicClient 返回共享的 dynamic 客户端.
+func (m *Manager) DynamicClient() dynamic.Interface {
+	return m.dynamicClient
+}
+
+// DiscoveryClient 返回共享的 discovery 客户端，集群提供的 API 在第一次使用时缓存，
+// 需要最新的 API 时调用返回值的 Invalidate 方法.
+func (m *Manager) DiscoveryClient() discovery.CachedDiscoveryInterface {
+	return m.discoveryClient
+}
+
+// RESTMapper 返回共享的 RESTMapper，找不到资源类型时会刷新 discovery 缓存后重试.
+func (m *Manager) RESTMapper() meta.RESTMapper {
+	return m.mapper
 }
 
-func NewApiExtensionsClient() (*apiextensionsclientset.Clientset, error) {
-	config, err := getKubernetesConfig()
+// Verber 返回共享的 ResourceVerber，第一次调用时创建，创建失败时下次调用会重试.
+func (m *Manager) Verber() (clientapi.ResourceVerber, error) {
+	m.verberLock.Lock()
+	defer m.verberLock.Unlock()
+
+	if m.verber == nil {
+		verber, err := kclient.NewResourceVerberForConfig(m.config)
+		if err != nil {
+			return nil, err
+		}
+		m.verber = verber
+	}
+
+	return m.verber, nil
+}
+
+// Config 返回创建客户端时使用的 rest 配置.
+func (m *Manager) Config() *rest.Config {
+	return m.config
+}
+
+// Cluster 返回创建客户端时使用的集群配置.
+func (m *Manager) Cluster() ClusterConfig {
+	return m.cluster
+}
+
+func (m *Manager) init() error {
+	cfg, err := m.buildConfig()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	client, err := apiextensionsclientset.NewForConfig(config)
+	cfg.QPS = m.cluster.QPS
+	cfg.Burst = m.cluster.Burst
+
+	client, err := kubernetes.NewForConfig(cfg)
 	if err != nil {
+		return err
+	}
+
+	apiExtensionsClient, err := apiextensionsclientset.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	m.config = cfg
+	m.client = client
+	m.apiExtensionsClient = apiExtensionsClient
+	m.dynamicClient = dynamicClient
+	m.discoveryClient = memory.NewMemCacheClient(client.Discovery())
+	m.mapper = restmapper.NewDeferredDiscoveryRESTMapper(m.discoveryClient)
+	return nil
+}
+
+// Returns rest Config based on the cluster config. If neither kubeconfig nor apiserver host
+// is provided then in-cluster config is tried first and ~/.kube/config is used as a fallback.
+func (m *Manager) buildConfig() (*rest.Config, error) {
+	c := m.cluster
+	overrides := &clientcmd.ConfigOverrides{
+		CurrentContext: c.Context,
+		ClusterInfo:    clientcmdapi.Cluster{Server: c.APIServerHost},
+	}
+
+	if len(c.KubeConfig) > 0 {
+		log.Infof("Using inline kubeconfig for cluster %q", c.Name)
+		cfg, err := clientcmd.Load([]byte(c.KubeConfig))
+		if err != nil {
+			return nil, err
+		}
+		return clientcmd.NewNonInteractiveClientConfig(*cfg, c.Context, overrides, nil).ClientConfig()
+	}
+
+	if len(c.KubeConfigPath) > 0 || len(c.APIServerHost) > 0 {
+		log.Infof("Using kubeconfig %q and apiserver host %q for cluster %q", c.KubeConfigPath, c.APIServerHost, c.Name)
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			&clientcmd.ClientConfigLoadingRules{ExplicitPath: c.KubeConfigPath}, overrides).ClientConfig()
+	}
+
+	if len(c.Context) > 0 {
+		log.Infof("Using context %q of the default kubeconfig for cluster %q", c.Context, c.Name)
+		return clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
+			clientcmd.NewDefaultClientConfigLoadingRules(), overrides).ClientConfig()
+	}
+
+	cfg, err := rest.InClusterConfig()
+	if err == nil {
+		log.Infof("Using in-cluster config for cluster %q", c.Name)
+		return cfg, nil
+	}
+
+	home := homedir.HomeDir()
+	if home == "" {
 		return nil, err
 	}
 
+	kubeconfig := filepath.Join(home, ".kube", "config")
+	log.Infof("Using kubeconfig %q for cluster %q", kubeconfig, c.Name)
+	return clientcmd.BuildConfigFromFlags("", kubeconfig)
+}
+
+// FromContext 返回由中间件注入到 gin.Context 中的 Kubernetes 客户端.
+func FromContext(c *gin.Context) (kubernetes.Interface, error) {
+	v, ok := c.Get(ContextKey)
+	if !ok {
+		return nil, errors.New("kubernetes client is not set in the request context")
+	}
+
+	client, ok := v.(kubernetes.Interface)
+	if !ok {
+		return nil, errors.New("invalid kubernetes client in the request context")
+	}
+
 	return client, nil
 }
 
-func getKubernetesConfig() (*rest.Config, error) {
-	var kubeconfig *string
-	var tmp string
-	if home := homedir.HomeDir(); home != "" {
-		log.Infof("home dir is:%v", home)
-		tmp = filepath.Join(home, ".kube", "config")
-		log.Infof("config path is:%v", tmp)
-		kubeconfig = &tmp
+// ManagerFromContext 返回由中间件注入到 gin.Context 中的当前集群的客户端管理器.
+func ManagerFromContext(c *gin.Context) (*Manager, error) {
+	v, ok := c.Get(ManagerContextKey)
+	if !ok {
+		return nil, errors.New("kubernetes client manager is not set in the request context")
 	}
 
-	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
-	if err != nil {
-		return nil, err
+	m, ok := v.(*Manager)
+	if !ok {
+		return nil, errors.New("invalid kubernetes client manager in the request context")
+	}
+
+	return m, nil
+}
+
+// ConfigFromContext 返回由中间件注入到 gin.Context 中的 rest 配置的副本，调用者可以修改返回的配置.
+func ConfigFromContext(c *gin.Context) (*rest.Config, error) {
+	v, ok := c.Get(ConfigContextKey)
+	if !ok {
+		return nil, errors.New("kubernetes config is not set in the request context")
+	}
+
+	cfg, ok := v.(*rest.Config)
+	if !ok {
+		return nil, errors.New("invalid kubernetes config in the request context")
 	}
 
-	return config, nil
+	return rest.CopyConfig(cfg), nil
 }
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lexkong/log"
+)
+
+func init() {
+	log.InitWithConfig(&log.PassLagerCfg{
+		Writers:     "stdout",
+		LoggerLevel: "ERROR",
+		LoggerFile:  filepath.Join(os.TempDir(), "hello-k8s-test.log"),
+	})
+}
+
+func TestManagerSharedClients(t *testing.T) {
+	// Nothing listens on the port, the clients are created without connecting.
+	m, err := NewManager(ClusterConfig{Name: "test", APIServerHost: "http://127.0.0.1:1"})
+	if err != nil {
+		t.Fatalf("NewManager(): unexpected error: %v", err)
+	}
+	defer m.Close()
+
+	if m.DynamicClient() == nil || m.DiscoveryClient() == nil || m.RESTMapper() == nil {
+		t.Fatalf("NewManager(): expected dynamic client, discovery client and mapper to be created")
+	}
+	if m.DynamicClient() != m.DynamicClient() || m.RESTMapper() != m.RESTMapper() {
+		t.Errorf("expected the clients to be shared between calls")
+	}
+
+	// The verber is not cached when the cluster can not be reached.
+	if _, err := m.Verber(); err == nil {
+		t.Errorf("Verber(): expected error for unreachable cluster")
+	}
+	if m.verber != nil {
+		t.Errorf("Verber(): expected failed verber not to be cached")
+	}
+}
@@ -0,0 +1,40 @@
+package client
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// ValidateKubeConfig 检查通过 API 提交的 kubeconfig 内容. exec 和 auth-provider 插件会在服务所在的
+// 主机上执行命令，client-certificate、token-file 等文件路径会读取服务所在主机上的文件，所以
+// kubeconfig 中的证书和凭证必须以内联数据的形式提供.
+func ValidateKubeConfig(kubeconfig string) error {
+	cfg, err := clientcmd.Load([]byte(kubeconfig))
+	if err != nil {
+		return err
+	}
+
+	for name, cluster := range cfg.Clusters {
+		if cluster.CertificateAuthority != "" {
+			return fmt.Errorf("cluster %q: certificate-authority file is not allowed, use certificate-authority-data", name)
+		}
+	}
+
+	for name, user := range cfg.AuthInfos {
+		switch {
+		case user.Exec != nil:
+			return fmt.Errorf("user %q: exec plugins are not allowed", name)
+		case user.AuthProvider != nil:
+			return fmt.Errorf("user %q: auth-provider plugins are not allowed", name)
+		case user.ClientCertificate != "":
+			return fmt.Errorf("user %q: client-certificate file is not allowed, use client-certificate-data", name)
+		case user.ClientKey != "":
+			return fmt.Errorf("user %q: client-key file is not allowed, use client-key-data", name)
+		case user.TokenFile != "":
+			return fmt.Errorf("user %q: token-file is not allowed, use token", name)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+const testKubeConfig = `apiVersion: v1
+kind: Config
+current-context: test
+clusters:
+- name: test
+  cluster:
+    server: https://127.0.0.1:6443
+%s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+users:
+- name: test
+  user:
+%s
+`
+
+func TestValidateKubeConfig(t *testing.T) {
+	cases := []struct {
+		cluster, user string
+		valid         bool
+	}{
+		{"    certificate-authority-data: Y2E=", "    token: abc", true},
+		{"", "    client-certificate-data: Y2VydA==\n    client-key-data: a2V5", true},
+		{"    certificate-authority: /etc/kubernetes/pki/ca.crt", "    token: abc", false},
+		{"", "    client-certificate: /root/.kube/cert.pem", false},
+		{"", "    client-key: /root/.kube/key.pem", false},
+		{"", "    tokenFile: /var/run/secrets/token", false},
+		{"", "    exec:\n      apiVersion: client.authentication.k8s.io/v1alpha1\n      command: /bin/sh", false},
+		{"", "    auth-provider:\n      name: gcp", false},
+	}
+
+	for _, c := range cases {
+		kubeconfig := strings.Replace(testKubeConfig, "%s", c.cluster, 1)
+		kubeconfig = strings.Replace(kubeconfig, "%s", c.user, 1)
+		err := ValidateKubeConfig(kubeconfig)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateKubeConfig(%q, %q) returned %v, expected valid %v", c.cluster, c.user, err, c.valid)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	// DefaultClusterName 未在配置文件中指定 kubernetes.default_cluster 时默认集群的名称.
+	DefaultClusterName = "default"
+	// RegistryContextKey 是集群注册表在 gin.Context 中的键名.
+	RegistryContextKey = "KubernetesClusterRegistry"
+)
+
+var (
+	// ErrClusterNotFound 表示注册表中不存在指定名称的集群.
+	ErrClusterNotFound = errors.New("cluster not found")
+	// ErrClusterExists 表示注册表中已存在同名集群.
+	ErrClusterExists = errors.New("cluster already exists")
+)
+
+// Registry 按名称管理多个 Kubernetes 集群的客户端.
+type Registry struct {
+	lock           sync.RWMutex
+	defaultCluster string
+	managers       map[string]*Manager
+	// Whether the clusters added to the registry get an informer backed read cache.
+	cacheEnabled bool
+	cacheResync  time.Duration
+}
+
+// NewRegistry 创建一个空的集群注册表，defaultCluster 为未指定集群时使用的集群名称.
+func NewRegistry(defaultCluster string) *Registry {
+	return &Registry{
+		defaultCluster: defaultCluster,
+		managers:       make(map[string]*Manager),
+	}
+}
+
+// NewRegistryFromConfig 使用 viper 中 kubernetes.* 配置项创建集群注册表.
+//
+// 默认集群由 kubernetes.kubeconfig、kubernetes.apiserver_host、kubernetes.qps 和
+// kubernetes.burst 配置，其他集群在 kubernetes.clusters 列表中配置. 如果列表中存在与
+// kubernetes.default_cluster 同名的集群，则使用该集群作为默认集群.
+//
+// kubernetes.cache.enabled 为 true 时，所有集群都会启用读缓存，缓存的全量同步周期由
+// kubernetes.cache.resync_period 配置.
+func NewRegistryFromConfig() (*Registry, error) {
+	defaultCluster := viper.GetString("kubernetes.default_cluster")
+	if defaultCluster == "" {
+		defaultCluster = DefaultClusterName
+	}
+
+	var clusters []ClusterConfig
+	if err := viper.UnmarshalKey("kubernetes.clusters", &clusters); err != nil {
+		return nil, err
+	}
+
+	hasDefault := false
+	for _, cluster := range clusters {
+		if cluster.Name == defaultCluster {
+			hasDefault = true
+			break
+		}
+	}
+	if !hasDefault {
+		clusters = append(clusters, ClusterConfig{
+			Name:           defaultCluster,
+			KubeConfigPath: viper.GetString("kubernetes.kubeconfig"),
+			APIServerHost:  viper.GetString("kubernetes.apiserver_host"),
+			QPS:            float32(viper.GetFloat64("kubernetes.qps")),
+			Burst:          viper.GetInt("kubernetes.burst"),
+		})
+	}
+
+	r := NewRegistry(defaultCluster)
+	if viper.GetBool("kubernetes.cache.enabled") {
+		r.EnableCache(viper.GetDuration("kubernetes.cache.resync_period"))
+	}
+	for _, cluster := range clusters {
+		m, err := NewManager(cluster)
+		if err != nil {
+			// 默认集群必须可用，其他集群配置错误时只记录日志.
+			if cluster.Name == defaultCluster {
+				return nil, err
+			}
+			log.Errorf(err, "Skipping cluster %q", cluster.Name)
+			continue
+		}
+
+		if err := r.Add(cluster.Name, m); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+// EnableCache 为之后注册的集群启用读缓存.
+func (r *Registry) EnableCache(resync time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.cacheEnabled = true
+	r.cacheResync = resync
+}
+
+// Add 注册一个集群.
+func (r *Registry) Add(name string, m *Manager) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.managers[name]; ok {
+		return fmt.Errorf("%w: %s", ErrClusterExists, name)
+	}
+
+	if r.cacheEnabled {
+		m.EnableCache(r.cacheResync)
+	}
+
+	r.managers[name] = m
+	return nil
+}
+
+// Remove 移除一个集群，默认集群不可移除.
+func (r *Registry) Remove(name string) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if name == r.defaultCluster {
+		return fmt.Errorf("default cluster %s can not be removed", name)
+	}
+
+	if _, ok := r.managers[name]; !ok {
+		return fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+
+	r.managers[name].Close()
+	delete(r.managers, name)
+	return nil
+}
+
+// Get 返回指定集群的客户端管理器，name 为空时返回默认集群.
+func (r *Registry) Get(name string) (*Manager, error) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	if name == "" {
+		name = r.defaultCluster
+	}
+
+	m, ok := r.managers[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrClusterNotFound, name)
+	}
+
+	return m, nil
+}
+
+// Default 返回默认集群名称.
+func (r *Registry) Default() string {
+	return r.defaultCluster
+}
+
+// Names 返回按名称排序的所有集群名称.
+func (r *Registry) Names() []string {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	names := make([]string, 0, len(r.managers))
+	for name := range r.managers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// RegistryFromContext 返回由中间件注入到 gin.Context 中的集群注册表.
+func RegistryFromContext(c *gin.Context) (*Registry, error) {
+	v, ok := c.Get(RegistryContextKey)
+	if !ok {
+		return nil, errors.New("cluster registry is not set in the request context")
+	}
+
+	registry, ok := v.(*Registry)
+	if !ok {
+		return nil, errors.New("invalid cluster registry in the request context")
+	}
+
+	return registry, nil
+}
@@ -204,7 +204,7 @@ var KindToAPIMapping = map[string]APIMapping{
 	ResourceKindIngress:                  {"ingresses", ClientTypeExtensionClient, true},
 	ResourceKindJob:                      {"jobs", ClientTypeBatchClient, true},
 	ResourceKindCronJob:                  {"cronjobs", ClientTypeBetaBatchClient, true},
-	ResourceKindLimitRange:               {"limitrange", ClientTypeDefault, true},
+	ResourceKindLimitRange:               {"limitranges", ClientTypeDefault, true},
 	ResourceKindNamespace:                {"namespaces", ClientTypeDefault, false},
 	ResourceKindNode:                     {"nodes", ClientTypeDefault, false},
 	ResourceKindPersistentVolumeClaim:    {"persistentvolumeclaims", ClientTypeDefault, true},
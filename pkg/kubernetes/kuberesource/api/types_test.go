@@ -15,6 +15,7 @@
 package api
 
 import (
+	"strings"
 	"testing"
 
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -87,3 +88,12 @@ func TestIsLabelSelectorMatching(t *testing.T) {
 		}
 	}
 }
+
+func TestKindToAPIMappingUsesPluralResources(t *testing.T) {
+	for kind, mapping := range KindToAPIMapping {
+		if !strings.HasSuffix(mapping.Resource, "s") {
+			t.Errorf("KindToAPIMapping[%q].Resource == %q, expected a plural resource name",
+				kind, mapping.Resource)
+		}
+	}
+}
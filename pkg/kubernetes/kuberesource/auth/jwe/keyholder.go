@@ -174,3 +174,41 @@ func NewRSAKeyHolder(synchronizer syncApi.Synchronizer) KeyHolder {
 	holder.init()
 	return holder
 }
+
+// Implements KeyHolder interface. Key is provided on creation and is not synchronized with any kubernetes resource.
+type staticRSAKeyHolder struct {
+	key *rsa.PrivateKey
+}
+
+// Encrypter implements key holder interface. See KeyHolder for more information.
+func (self *staticRSAKeyHolder) Encrypter() jose.Encrypter {
+	encrypter, err := jose.NewEncrypter(jose.A256GCM, jose.Recipient{Algorithm: jose.RSA_OAEP_256, Key: &self.key.PublicKey}, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	return encrypter
+}
+
+// Key implements key holder interface. See KeyHolder for more information.
+func (self *staticRSAKeyHolder) Key() *rsa.PrivateKey {
+	return self.key
+}
+
+// Refresh implements key holder interface. Static key can not be refreshed so it does nothing.
+func (self *staticRSAKeyHolder) Refresh() {}
+
+// NewStaticRSAKeyHolder creates new KeyHolder instance that always uses given key. If key is nil then new key is
+// generated.
+func NewStaticRSAKeyHolder(key *rsa.PrivateKey) KeyHolder {
+	if key == nil {
+		log.Print("Generating JWE encryption key")
+		var err error
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return &staticRSAKeyHolder{key: key}
+}
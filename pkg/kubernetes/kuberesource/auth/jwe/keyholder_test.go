@@ -48,3 +48,20 @@ func TestRsaKeyHolder_Key(t *testing.T) {
 		t.Fatalf("Key(): Expected key not to be nil")
 	}
 }
+
+func TestStaticRsaKeyHolder(t *testing.T) {
+	holder := NewStaticRSAKeyHolder(nil)
+	if holder.Key() == nil {
+		t.Fatalf("Key(): Expected key not to be nil")
+	}
+
+	if holder.Encrypter() == nil {
+		t.Fatalf("Encrypter(): Expected encrypter not to be nil")
+	}
+
+	key := holder.Key()
+	holder.Refresh()
+	if holder.Key() != key {
+		t.Fatalf("Refresh(): Expected static key not to change")
+	}
+}
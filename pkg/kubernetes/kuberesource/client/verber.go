@@ -20,13 +20,16 @@ import (
 
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsv1beta1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 
 	"hello-k8s/pkg/kubernetes/kuberesource/api"
 	clientapi "hello-k8s/pkg/kubernetes/kuberesource/client/api"
 	"hello-k8s/pkg/kubernetes/kuberesource/errors"
+	pluginclientset "hello-k8s/pkg/kubernetes/kuberesource/plugin/client/clientset/versioned"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/customresourcedefinition"
 )
 
@@ -169,6 +172,42 @@ func NewResourceVerber(client, extensionsClient, appsClient, batchClient, betaBa
 		batchClient, betaBatchClient, autoscalingClient, storageClient, rbacClient, apiExtensionsClient, pluginsClient, config}
 }
 
+// NewResourceVerberForConfig creates a new resource verber with clients created from the given config.
+func NewResourceVerberForConfig(config *restclient.Config) (clientapi.ResourceVerber, error) {
+	k8sClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	apiextensionsclient, err := apiextensionsclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	pluginsclient, err := pluginclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	apiextensionsRestClient, err := customresourcedefinition.GetExtensionsAPIRestClient(apiextensionsclient)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResourceVerber(
+		k8sClient.CoreV1().RESTClient(),
+		k8sClient.ExtensionsV1beta1().RESTClient(),
+		k8sClient.AppsV1().RESTClient(),
+		k8sClient.BatchV1().RESTClient(),
+		k8sClient.BatchV1beta1().RESTClient(),
+		k8sClient.AutoscalingV1().RESTClient(),
+		k8sClient.StorageV1().RESTClient(),
+		k8sClient.RbacV1().RESTClient(),
+		apiextensionsRestClient,
+		pluginsclient.DashboardV1alpha1().RESTClient(),
+		config), nil
+}
+
 // Delete deletes the resource of the given kind in the given namespace with the given name.
 func (verber *resourceVerber) Delete(kind string, namespaceSet bool, namespace string, name string) error {
 	client, resourceSpec, err := verber.getResourceSpecFromKind(kind, namespaceSet)
@@ -126,6 +126,14 @@ func GetLogFile(client kubernetes.Interface, namespace, podID string, container
 	return logStream, err
 }
 
+// FollowLogs returns a stream that follows the log of the container, new log lines are written to the stream as they
+// arrive. The stream ends when the container terminates, closing it stops following the log.
+func FollowLogs(client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (io.ReadCloser, error) {
+	options := logOptions.DeepCopy()
+	options.Follow = true
+	return openStream(client, namespace, podID, options)
+}
+
 func openStream(client kubernetes.Interface, namespace, podID string, logOptions *v1.PodLogOptions) (io.ReadCloser, error) {
 	return client.CoreV1().RESTClient().Get().
 		Namespace(namespace).
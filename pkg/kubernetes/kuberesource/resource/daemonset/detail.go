@@ -21,6 +21,8 @@ import (
 	metricapi "hello-k8s/pkg/kubernetes/kuberesource/integration/metric/api"
 	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
 
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClient "k8s.io/client-go/kubernetes"
@@ -33,6 +35,24 @@ type DaemonSetDetail struct {
 
 	LabelSelector *v1.LabelSelector `json:"labelSelector,omitempty"`
 
+	// Node selector of the pods.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations of the pods.
+	Tolerations []api.Toleration `json:"tolerations,omitempty"`
+
+	// Update strategy, RollingUpdate or OnDelete.
+	UpdateStrategy apps.DaemonSetUpdateStrategy `json:"updateStrategy"`
+
+	// Number of nodes that should run the daemon pod.
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// Number of nodes that are running the updated daemon pod.
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled"`
+
+	// Number of nodes that have an available daemon pod.
+	NumberAvailable int32 `json:"numberAvailable"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -63,8 +83,14 @@ func GetDaemonSetDetail(client k8sClient.Interface, metricClient metricapi.Metri
 	}
 
 	return &DaemonSetDetail{
-		DaemonSet:     toDaemonSet(*daemonSet, podList.Items, eventList.Items),
-		LabelSelector: daemonSet.Spec.Selector,
-		Errors:        []error{},
+		DaemonSet:              toDaemonSet(*daemonSet, podList.Items, eventList.Items),
+		LabelSelector:          daemonSet.Spec.Selector,
+		NodeSelector:           daemonSet.Spec.Template.Spec.NodeSelector,
+		Tolerations:            daemonSet.Spec.Template.Spec.Tolerations,
+		UpdateStrategy:         daemonSet.Spec.UpdateStrategy,
+		DesiredNumberScheduled: daemonSet.Status.DesiredNumberScheduled,
+		UpdatedNumberScheduled: daemonSet.Status.UpdatedNumberScheduled,
+		NumberAvailable:        daemonSet.Status.NumberAvailable,
+		Errors:                 []error{},
 	}, nil
 }
@@ -0,0 +1,90 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"context"
+	"fmt"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+// UpdateOptions are the changes of the image and the update strategy of a daemon set.
+type UpdateOptions struct {
+	// Container whose image is replaced, the image is not changed if it is empty.
+	Container string `json:"container"`
+
+	// New image of the container.
+	Image string `json:"image"`
+
+	// Update strategy, RollingUpdate or OnDelete. The strategy is not changed if it is empty.
+	StrategyType apps.DaemonSetUpdateStrategyType `json:"strategyType"`
+
+	// The maximum number of pods that can be unavailable during a rolling update, can be an
+	// absolute number (ex: 5) or a percentage of the scheduled pods (ex: 10%).
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" swaggertype:"string"`
+}
+
+// UpdateDaemonSet changes the update strategy of the daemon set and replaces the container image.
+// With the RollingUpdate strategy a new image is rolled out to all nodes at once, with the OnDelete
+// strategy the pods are only updated when they are deleted.
+func UpdateDaemonSet(client kubernetes.Interface, namespace, name string, opts UpdateOptions) (*apps.DaemonSet, error) {
+	daemonSet, err := client.AppsV1().DaemonSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Container != "" &&
+		!setContainerImage(daemonSet.Spec.Template.Spec.Containers, opts.Container, opts.Image) &&
+		!setContainerImage(daemonSet.Spec.Template.Spec.InitContainers, opts.Container, opts.Image) {
+		return nil, fmt.Errorf("container %s not found in daemon set %s", opts.Container, name)
+	}
+
+	strategy := &daemonSet.Spec.UpdateStrategy
+	if opts.StrategyType != "" {
+		strategy.Type = opts.StrategyType
+	}
+
+	switch strategy.Type {
+	case apps.OnDeleteDaemonSetStrategyType:
+		if opts.MaxUnavailable != nil {
+			return nil, fmt.Errorf("max unavailable can not be set for the %s strategy", strategy.Type)
+		}
+		strategy.RollingUpdate = nil
+	case apps.RollingUpdateDaemonSetStrategyType:
+		if opts.MaxUnavailable != nil {
+			strategy.RollingUpdate = &apps.RollingUpdateDaemonSet{MaxUnavailable: opts.MaxUnavailable}
+		}
+	default:
+		return nil, fmt.Errorf("unknown update strategy %s", strategy.Type)
+	}
+
+	return client.AppsV1().DaemonSets(namespace).Update(context.TODO(), daemonSet, metaV1.UpdateOptions{})
+}
+
+func setContainerImage(containers []v1.Container, name, image string) bool {
+	for i := range containers {
+		if containers[i].Name == name {
+			containers[i].Image = image
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestUpdateDaemonSet(t *testing.T) {
+	maxUnavailable := intstr.FromString("20%")
+	cases := []struct {
+		opts     UpdateOptions
+		image    string
+		expected apps.DaemonSetUpdateStrategy
+	}{
+		{
+			UpdateOptions{Container: "agent", Image: "fluentd:v2"},
+			"fluentd:v2",
+			apps.DaemonSetUpdateStrategy{Type: apps.RollingUpdateDaemonSetStrategyType},
+		},
+		{
+			UpdateOptions{MaxUnavailable: &maxUnavailable},
+			"fluentd:v1",
+			apps.DaemonSetUpdateStrategy{
+				Type:          apps.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &apps.RollingUpdateDaemonSet{MaxUnavailable: &maxUnavailable},
+			},
+		},
+		{
+			UpdateOptions{Container: "agent", Image: "fluentd:v2", StrategyType: apps.OnDeleteDaemonSetStrategyType},
+			"fluentd:v2",
+			apps.DaemonSetUpdateStrategy{Type: apps.OnDeleteDaemonSetStrategyType},
+		},
+	}
+
+	for _, c := range cases {
+		daemonSet := CreateDaemonSet("agent", TestNamespace, TestLabel)
+		daemonSet.Spec.Template.Spec.Containers = []api.Container{{Name: "agent", Image: "fluentd:v1"}}
+		daemonSet.Spec.UpdateStrategy = apps.DaemonSetUpdateStrategy{Type: apps.RollingUpdateDaemonSetStrategyType}
+		client := fake.NewSimpleClientset(&daemonSet)
+
+		updated, err := UpdateDaemonSet(client, TestNamespace, "agent", c.opts)
+		if err != nil {
+			t.Fatalf("UpdateDaemonSet(%#v) returned error: %s", c.opts, err)
+		}
+		if image := updated.Spec.Template.Spec.Containers[0].Image; image != c.image {
+			t.Errorf("UpdateDaemonSet(%#v) set image %s, expected %s", c.opts, image, c.image)
+		}
+		if !reflect.DeepEqual(updated.Spec.UpdateStrategy, c.expected) {
+			t.Errorf("UpdateDaemonSet(%#v) set strategy %#v, expected %#v", c.opts, updated.Spec.UpdateStrategy, c.expected)
+		}
+	}
+}
+
+func TestUpdateDaemonSetErrors(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	cases := []UpdateOptions{
+		{Container: "missing", Image: "x"},
+		{StrategyType: apps.OnDeleteDaemonSetStrategyType, MaxUnavailable: &maxUnavailable},
+		{StrategyType: "Unknown"},
+	}
+
+	for _, opts := range cases {
+		daemonSet := CreateDaemonSet("agent", TestNamespace, TestLabel)
+		daemonSet.Spec.UpdateStrategy = apps.DaemonSetUpdateStrategy{Type: apps.RollingUpdateDaemonSetStrategyType}
+		client := fake.NewSimpleClientset(&daemonSet)
+
+		if _, err := UpdateDaemonSet(client, TestNamespace, "agent", opts); err == nil {
+			t.Errorf("UpdateDaemonSet(%#v) should return error", opts)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/errors"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// ApplyActionCreated means the object did not exist and has been created.
+	ApplyActionCreated = "created"
+	// ApplyActionUpdated means the existing object has been replaced.
+	ApplyActionUpdated = "updated"
+	// ApplyActionValid means the object has been validated only.
+	ApplyActionValid = "valid"
+	// ApplyActionFailed means the object could not be created or updated.
+	ApplyActionFailed = "failed"
+)
+
+// ManifestObject is an object of a manifest together with the resource that serves it.
+type ManifestObject struct {
+	Object     *unstructured.Unstructured
+	Resource   schema.GroupVersionResource
+	Namespaced bool
+}
+
+// ApplyResult is the outcome of applying an object of a manifest.
+type ApplyResult struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+
+	// Action is one of created, updated, valid and failed.
+	Action string `json:"action"`
+
+	// Error is the reason of the failure.
+	Error string `json:"error,omitempty"`
+}
+
+// ParseManifest decodes a multi-document yaml or json manifest and resolves the resource of every
+// object through discovery. A non-empty namespace overrides the namespace of the namespaced objects,
+// which otherwise default to the "default" namespace. Nothing is returned if any object is invalid.
+func ParseManifest(discoveryClient discovery.DiscoveryInterface, content, namespace string) ([]ManifestObject, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(strings.NewReader(content), 4096)
+	resources := make(map[string]*metaV1.APIResourceList)
+	objects := make([]ManifestObject, 0)
+
+	for i := 1; ; i++ {
+		data := &unstructured.Unstructured{}
+		if err := decoder.Decode(data); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+
+		// Skip empty documents, e.g. a trailing "---".
+		if len(data.Object) == 0 {
+			continue
+		}
+
+		version := data.GetAPIVersion()
+		kind := data.GetKind()
+		if version == "" || kind == "" {
+			return nil, fmt.Errorf("document %d: apiVersion and kind are required", i)
+		}
+
+		gv, err := schema.ParseGroupVersion(version)
+		if err != nil {
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+
+		if _, ok := resources[version]; !ok {
+			list, err := discoveryClient.ServerResourcesForGroupVersion(version)
+			if err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			resources[version] = list
+		}
+
+		var resource *metaV1.APIResource
+		for j := range resources[version].APIResources {
+			apiResource := &resources[version].APIResources[j]
+			if apiResource.Kind == kind && !strings.Contains(apiResource.Name, "/") {
+				resource = apiResource
+				break
+			}
+		}
+		if resource == nil {
+			return nil, fmt.Errorf("document %d: unknown resource kind %s in %s", i, kind, version)
+		}
+
+		if resource.Namespaced {
+			if namespace != "" {
+				data.SetNamespace(namespace)
+			} else if data.GetNamespace() == "" {
+				data.SetNamespace(metaV1.NamespaceDefault)
+			}
+		} else {
+			data.SetNamespace("")
+		}
+
+		objects = append(objects, ManifestObject{
+			Object:     data,
+			Resource:   gv.WithResource(resource.Name),
+			Namespaced: resource.Namespaced,
+		})
+	}
+}
+
+// ApplyObjects creates the objects that do not exist yet and replaces the existing ones. Failures
+// are reported in the results and do not stop the remaining objects from being applied. With
+// dryRun the requests are validated by the apiserver but not persisted.
+func ApplyObjects(client dynamic.Interface, objects []ManifestObject, dryRun bool) []ApplyResult {
+	var dryRunOption []string
+	if dryRun {
+		dryRunOption = []string{metaV1.DryRunAll}
+	}
+
+	results := make([]ApplyResult, 0, len(objects))
+	for _, object := range objects {
+		result := NewApplyResult(object)
+		action, err := applyObject(client, object, dryRunOption)
+		if err != nil {
+			result.Action = ApplyActionFailed
+			result.Error = errors.LocalizeError(err).Error()
+		} else {
+			result.Action = action
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// NewApplyResult returns the result of the object without an action.
+func NewApplyResult(object ManifestObject) ApplyResult {
+	return ApplyResult{
+		APIVersion: object.Object.GetAPIVersion(),
+		Kind:       object.Object.GetKind(),
+		Name:       object.Object.GetName(),
+		Namespace:  object.Object.GetNamespace(),
+	}
+}
+
+func applyObject(client dynamic.Interface, object ManifestObject, dryRun []string) (string, error) {
+	var resource dynamic.ResourceInterface = client.Resource(object.Resource)
+	if object.Namespaced {
+		resource = client.Resource(object.Resource).Namespace(object.Object.GetNamespace())
+	}
+
+	// Objects with a generated name are always created.
+	if object.Object.GetName() == "" {
+		_, err := resource.Create(context.TODO(), object.Object, metaV1.CreateOptions{DryRun: dryRun})
+		return ApplyActionCreated, err
+	}
+
+	existing, err := resource.Get(context.TODO(), object.Object.GetName(), metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		_, err := resource.Create(context.TODO(), object.Object, metaV1.CreateOptions{DryRun: dryRun})
+		return ApplyActionCreated, err
+	}
+	if err != nil {
+		return "", err
+	}
+
+	object.Object.SetResourceVersion(existing.GetResourceVersion())
+	preserveClusterIP(object.Object, existing)
+	_, err = resource.Update(context.TODO(), object.Object, metaV1.UpdateOptions{DryRun: dryRun})
+	return ApplyActionUpdated, err
+}
+
+// The cluster IP of a service is immutable, so the allocated one is kept if the manifest does
+// not set it.
+func preserveClusterIP(object, existing *unstructured.Unstructured) {
+	if object.GetKind() != "Service" {
+		return
+	}
+
+	if ip, _, _ := unstructured.NestedString(object.Object, "spec", "clusterIP"); ip != "" {
+		return
+	}
+	if ip, ok, _ := unstructured.NestedString(existing.Object, "spec", "clusterIP"); ok {
+		unstructured.SetNestedField(object.Object, ip, "spec", "clusterIP")
+	}
+}
@@ -0,0 +1,154 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+const testManifest = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  namespace: team-a
+data:
+  key: value
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: team-b
+---
+`
+
+func newFakeDiscovery() *fakediscovery.FakeDiscovery {
+	client := fake.NewSimpleClientset()
+	discovery := client.Discovery().(*fakediscovery.FakeDiscovery)
+	discovery.Resources = []*metaV1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "configmaps", Kind: "ConfigMap", Namespaced: true},
+				{Name: "secrets", Kind: "Secret", Namespaced: true},
+				{Name: "pods", Kind: "Pod", Namespaced: true},
+				{Name: "namespaces", Kind: "Namespace"},
+				{Name: "namespaces/status", Kind: "Namespace"},
+			},
+		},
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metaV1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true},
+			},
+		},
+	}
+
+	return discovery
+}
+
+func TestParseManifest(t *testing.T) {
+	cases := []struct {
+		namespace  string
+		namespaces []string
+	}{
+		{"", []string{"team-a", "default", ""}},
+		{"override", []string{"override", "override", ""}},
+	}
+
+	for _, c := range cases {
+		objects, err := ParseManifest(newFakeDiscovery(), testManifest, c.namespace)
+		if err != nil {
+			t.Fatalf("ParseManifest() returned error: %s", err)
+		}
+		if len(objects) != len(c.namespaces) {
+			t.Fatalf("ParseManifest() returned %d objects, expected %d", len(objects), len(c.namespaces))
+		}
+
+		for i, object := range objects {
+			if object.Object.GetNamespace() != c.namespaces[i] {
+				t.Errorf("object %d with namespace override %q is in namespace %q, expected %q",
+					i, c.namespace, object.Object.GetNamespace(), c.namespaces[i])
+			}
+		}
+
+		if objects[1].Resource != (schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}) {
+			t.Errorf("unexpected resource of the deployment: %#v", objects[1].Resource)
+		}
+	}
+}
+
+func TestParseManifestErrors(t *testing.T) {
+	cases := []string{
+		"kind: ConfigMap\nmetadata:\n  name: missing-version\n",
+		"apiVersion: v1\nkind: Unknown\nmetadata:\n  name: unknown\n",
+		"apiVersion: v1\nkind: [\n",
+	}
+
+	for _, content := range cases {
+		if _, err := ParseManifest(newFakeDiscovery(), content, ""); err == nil {
+			t.Errorf("ParseManifest(%q) should return error", content)
+		}
+	}
+}
+
+func TestApplyObjects(t *testing.T) {
+	existing := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "settings",
+			"namespace": "team-a",
+		},
+		"data": map[string]interface{}{"key": "old"},
+	}}
+	client := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), existing)
+
+	objects, err := ParseManifest(newFakeDiscovery(), testManifest, "")
+	if err != nil {
+		t.Fatalf("ParseManifest() returned error: %s", err)
+	}
+
+	results := ApplyObjects(client, objects, false)
+	expected := []string{ApplyActionUpdated, ApplyActionCreated, ApplyActionCreated}
+	for i, result := range results {
+		if result.Action != expected[i] || result.Error != "" {
+			t.Errorf("result %d == %#v, expected action %s", i, result, expected[i])
+		}
+	}
+
+	configMaps := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	updated, err := client.Resource(configMaps).Namespace("team-a").Get(context.TODO(), "settings", metaV1.GetOptions{})
+	if err != nil {
+		t.Fatalf("getting updated config map: %s", err)
+	}
+	if value, _, _ := unstructured.NestedString(updated.Object, "data", "key"); value != "value" {
+		t.Errorf("expected config map to be updated, got data.key %q", value)
+	}
+}
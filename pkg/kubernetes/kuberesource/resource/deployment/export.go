@@ -0,0 +1,294 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
+)
+
+// ExportKinds are the kinds exported together with a namespace, in the order they are written.
+// Kinds that are not served by the cluster are skipped.
+var ExportKinds = []schema.GroupKind{
+	{Kind: "ServiceAccount"},
+	{Kind: "ConfigMap"},
+	{Kind: "Secret"},
+	{Kind: "LimitRange"},
+	{Kind: "ResourceQuota"},
+	{Kind: "PersistentVolumeClaim"},
+	{Kind: "Service"},
+	{Kind: "Pod"},
+	{Group: "apps", Kind: "Deployment"},
+	{Group: "apps", Kind: "StatefulSet"},
+	{Group: "apps", Kind: "DaemonSet"},
+	{Group: "batch", Kind: "Job"},
+	{Group: "batch", Kind: "CronJob"},
+	{Group: "autoscaling", Kind: "HorizontalPodAutoscaler"},
+	{Group: "networking.k8s.io", Kind: "Ingress"},
+}
+
+// Metadata fields populated by the apiserver.
+var serverMetadataFields = []string{
+	"creationTimestamp",
+	"deletionGracePeriodSeconds",
+	"deletionTimestamp",
+	"generation",
+	"managedFields",
+	"ownerReferences",
+	"resourceVersion",
+	"selfLink",
+	"uid",
+}
+
+// Annotations added by the apiserver, controllers and kubectl.
+var serverAnnotations = []string{
+	"deployment.kubernetes.io/revision",
+	"kubectl.kubernetes.io/last-applied-configuration",
+	"pv.kubernetes.io/bind-completed",
+	"pv.kubernetes.io/bound-by-controller",
+	"volume.beta.kubernetes.io/storage-provisioner",
+}
+
+// NewExportMapper creates a mapper between kinds and resources from the groups served by the cluster.
+func NewExportMapper(discoveryClient discovery.DiscoveryInterface) (meta.RESTMapper, error) {
+	groupResources, err := restmapper.GetAPIGroupResources(discoveryClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
+
+// ExportObject gets an object and strips the fields populated by the server. The resource is a
+// plural or singular resource name, optionally qualified by its group, e.g. "deployments.apps". The
+// namespace must be empty for cluster scoped resources and set for namespaced resources.
+func ExportObject(mapper meta.RESTMapper, client dynamic.Interface, resource, namespace, name string) (*unstructured.Unstructured, error) {
+	gvr, err := mapper.ResourceFor(schema.ParseGroupResource(strings.ToLower(resource)).WithVersion(""))
+	if err != nil {
+		return nil, err
+	}
+
+	gvk, err := mapper.KindFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaced := mapping.Scope.Name() == meta.RESTScopeNameNamespace
+	if namespaced && namespace == "" {
+		return nil, errors.NewInvalid(fmt.Sprintf("Set no namespace for namespaced resource: %s", resource))
+	}
+	if !namespaced && namespace != "" {
+		return nil, errors.NewInvalid(fmt.Sprintf("Set namespace for not-namespaced resource: %s", resource))
+	}
+
+	obj, err := client.Resource(mapping.Resource).Namespace(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	CleanObject(obj)
+	return obj, nil
+}
+
+// ExportNamespace returns the objects of the export kinds in the namespace without the fields
+// populated by the server. Objects managed by a controller, e.g. the pods of a deployment, and
+// objects created by the cluster itself are left out.
+func ExportNamespace(mapper meta.RESTMapper, client dynamic.Interface, namespace string) ([]*unstructured.Unstructured, error) {
+	objects := make([]*unstructured.Unstructured, 0)
+
+	for _, gk := range ExportKinds {
+		mapping, err := mapper.RESTMapping(gk)
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		list, err := client.Resource(mapping.Resource).Namespace(namespace).List(context.TODO(), metaV1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+
+		items := list.Items
+		sort.Slice(items, func(i, j int) bool { return items[i].GetName() < items[j].GetName() })
+
+		for i := range items {
+			obj := &items[i]
+			if !shouldExport(obj, gk) {
+				continue
+			}
+
+			obj.SetGroupVersionKind(mapping.GroupVersionKind)
+			CleanObject(obj)
+			objects = append(objects, obj)
+		}
+	}
+
+	return objects, nil
+}
+
+// Returns false for the objects managed by a controller or created by the cluster.
+func shouldExport(obj *unstructured.Unstructured, gk schema.GroupKind) bool {
+	if metaV1.GetControllerOf(obj) != nil {
+		return false
+	}
+
+	switch gk.Kind {
+	case "ServiceAccount":
+		return obj.GetName() != "default"
+	case "ConfigMap":
+		return obj.GetName() != "kube-root-ca.crt"
+	case "Secret":
+		secretType, _, _ := unstructured.NestedString(obj.Object, "type")
+		return secretType != "kubernetes.io/service-account-token"
+	case "Service":
+		return obj.GetNamespace() != metaV1.NamespaceDefault || obj.GetName() != "kubernetes"
+	}
+
+	return true
+}
+
+// CleanObject removes the status and the fields populated by the server, so that the object can
+// be created again, e.g. in another cluster.
+func CleanObject(obj *unstructured.Unstructured) {
+	unstructured.RemoveNestedField(obj.Object, "status")
+	for _, field := range serverMetadataFields {
+		unstructured.RemoveNestedField(obj.Object, "metadata", field)
+	}
+
+	annotations := obj.GetAnnotations()
+	for _, key := range serverAnnotations {
+		delete(annotations, key)
+	}
+	if len(annotations) == 0 {
+		unstructured.RemoveNestedField(obj.Object, "metadata", "annotations")
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+
+	switch obj.GetKind() {
+	case "Service":
+		// Headless services keep their cluster ip.
+		if clusterIP, _, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); clusterIP != "None" {
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+		}
+		unstructured.RemoveNestedField(obj.Object, "spec", "healthCheckNodePort")
+	case "PersistentVolumeClaim":
+		unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
+	case "Pod":
+		unstructured.RemoveNestedField(obj.Object, "spec", "nodeName")
+	case "ServiceAccount":
+		unstructured.RemoveNestedField(obj.Object, "secrets")
+	case "Job":
+		// The selector and the matching labels are generated unless the selector is set manually.
+		if manual, _, _ := unstructured.NestedBool(obj.Object, "spec", "manualSelector"); !manual {
+			unstructured.RemoveNestedField(obj.Object, "spec", "selector")
+			unstructured.RemoveNestedField(obj.Object, "spec", "template", "metadata", "labels", "controller-uid")
+			unstructured.RemoveNestedField(obj.Object, "spec", "template", "metadata", "labels", "job-name")
+		}
+	}
+}
+
+// EncodeYAML encodes the objects as a multi-document yaml manifest.
+func EncodeYAML(objects []*unstructured.Unstructured) ([]byte, error) {
+	var buf bytes.Buffer
+	for i, obj := range objects {
+		content, err := encodeObject(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(content)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteTarGz writes the objects to a gzip compressed tar archive with one yaml file per object,
+// named after the kind and the name of the object, e.g. deployment-web.yaml.
+func WriteTarGz(w io.Writer, objects []*unstructured.Unstructured) error {
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+	now := time.Now()
+
+	for _, obj := range objects {
+		content, err := encodeObject(obj)
+		if err != nil {
+			return err
+		}
+
+		header := &tar.Header{
+			Name:    ObjectFilename(obj),
+			Mode:    0644,
+			Size:    int64(len(content)),
+			ModTime: now,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if _, err := tw.Write(content); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+// ObjectFilename returns the name of the exported file of the object, e.g. deployment-web.yaml.
+func ObjectFilename(obj *unstructured.Unstructured) string {
+	return strings.ToLower(obj.GetKind()) + "-" + obj.GetName() + ".yaml"
+}
+
+func encodeObject(obj *unstructured.Unstructured) ([]byte, error) {
+	content, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(content)
+}
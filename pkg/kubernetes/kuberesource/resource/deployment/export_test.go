@@ -0,0 +1,237 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"testing"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"sigs.k8s.io/yaml"
+)
+
+func newTestObject(apiVersion, kind, namespace, name string, fields map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":              name,
+			"namespace":         namespace,
+			"uid":               "5c2f",
+			"resourceVersion":   "42",
+			"creationTimestamp": "2020-01-01T00:00:00Z",
+			"managedFields":     []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+	}}
+	for key, value := range fields {
+		obj.Object[key] = value
+	}
+
+	return obj
+}
+
+func newExportClient() *fakedynamic.FakeDynamicClient {
+	controller := true
+	ownedPod := newTestObject("v1", "Pod", "team-a", "web-5d8f", nil)
+	ownedPod.SetOwnerReferences([]metaV1.OwnerReference{
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "web-5d8f", UID: "1a2b", Controller: &controller},
+	})
+
+	return fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(),
+		newTestObject("v1", "ConfigMap", "team-a", "settings", map[string]interface{}{
+			"data": map[string]interface{}{"key": "value"},
+		}),
+		newTestObject("v1", "ConfigMap", "team-a", "kube-root-ca.crt", nil),
+		newTestObject("v1", "ConfigMap", "team-b", "other", nil),
+		newTestObject("v1", "Secret", "team-a", "default-token-x7k2p", map[string]interface{}{
+			"type": "kubernetes.io/service-account-token",
+		}),
+		newTestObject("v1", "Pod", "team-a", "debug", map[string]interface{}{
+			"spec":   map[string]interface{}{"nodeName": "node-1"},
+			"status": map[string]interface{}{"phase": "Running"},
+		}),
+		ownedPod,
+		newTestObject("apps/v1", "Deployment", "team-a", "web", map[string]interface{}{
+			"spec":   map[string]interface{}{"replicas": int64(2)},
+			"status": map[string]interface{}{"replicas": int64(2)},
+		}),
+	)
+}
+
+func TestCleanObject(t *testing.T) {
+	obj := newTestObject("v1", "Service", "team-a", "web", map[string]interface{}{
+		"spec": map[string]interface{}{
+			"clusterIP": "10.0.0.10",
+			"ports":     []interface{}{map[string]interface{}{"port": int64(80)}},
+		},
+		"status": map[string]interface{}{"loadBalancer": map[string]interface{}{}},
+	})
+	obj.SetAnnotations(map[string]string{
+		"kubectl.kubernetes.io/last-applied-configuration": "{}",
+		"team": "a",
+	})
+
+	CleanObject(obj)
+
+	expected := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":        "web",
+			"namespace":   "team-a",
+			"annotations": map[string]interface{}{"team": "a"},
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{map[string]interface{}{"port": int64(80)}},
+		},
+	}
+	if !reflect.DeepEqual(obj.Object, expected) {
+		t.Errorf("CleanObject() == %#v, expected %#v", obj.Object, expected)
+	}
+
+	headless := newTestObject("v1", "Service", "team-a", "db", map[string]interface{}{
+		"spec": map[string]interface{}{"clusterIP": "None"},
+	})
+	CleanObject(headless)
+	if clusterIP, _, _ := unstructured.NestedString(headless.Object, "spec", "clusterIP"); clusterIP != "None" {
+		t.Errorf("expected headless service to keep its cluster ip, got %q", clusterIP)
+	}
+}
+
+func TestExportObject(t *testing.T) {
+	mapper, err := NewExportMapper(newFakeDiscovery())
+	if err != nil {
+		t.Fatalf("NewExportMapper() returned error: %s", err)
+	}
+	client := newExportClient()
+
+	for _, resource := range []string{"deployment", "deployments", "deployments.apps"} {
+		obj, err := ExportObject(mapper, client, resource, "team-a", "web")
+		if err != nil {
+			t.Fatalf("ExportObject(%q) returned error: %s", resource, err)
+		}
+		if obj.GetKind() != "Deployment" || obj.GetResourceVersion() != "" {
+			t.Errorf("ExportObject(%q) returned unexpected object: %#v", resource, obj.Object)
+		}
+		if _, ok := obj.Object["status"]; ok {
+			t.Errorf("ExportObject(%q) should remove the status", resource)
+		}
+	}
+
+	cases := []struct {
+		resource  string
+		namespace string
+	}{
+		{"configmaps", ""},
+		{"namespaces", "team-a"},
+		{"unknown", "team-a"},
+	}
+	for _, c := range cases {
+		if _, err := ExportObject(mapper, client, c.resource, c.namespace, "settings"); err == nil {
+			t.Errorf("ExportObject(%q, %q) should return error", c.resource, c.namespace)
+		}
+	}
+}
+
+func TestExportNamespace(t *testing.T) {
+	mapper, err := NewExportMapper(newFakeDiscovery())
+	if err != nil {
+		t.Fatalf("NewExportMapper() returned error: %s", err)
+	}
+
+	objects, err := ExportNamespace(mapper, newExportClient(), "team-a")
+	if err != nil {
+		t.Fatalf("ExportNamespace() returned error: %s", err)
+	}
+
+	filenames := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		filenames = append(filenames, ObjectFilename(obj))
+	}
+	expected := []string{"configmap-settings.yaml", "pod-debug.yaml", "deployment-web.yaml"}
+	if !reflect.DeepEqual(filenames, expected) {
+		t.Fatalf("ExportNamespace() returned %v, expected %v", filenames, expected)
+	}
+
+	if _, ok, _ := unstructured.NestedString(objects[1].Object, "spec", "nodeName"); ok {
+		t.Errorf("expected the node name of the pod to be removed")
+	}
+}
+
+func TestEncodeObjects(t *testing.T) {
+	mapper, err := NewExportMapper(newFakeDiscovery())
+	if err != nil {
+		t.Fatalf("NewExportMapper() returned error: %s", err)
+	}
+	objects, err := ExportNamespace(mapper, newExportClient(), "team-a")
+	if err != nil {
+		t.Fatalf("ExportNamespace() returned error: %s", err)
+	}
+
+	content, err := EncodeYAML(objects)
+	if err != nil {
+		t.Fatalf("EncodeYAML() returned error: %s", err)
+	}
+	parsed, err := ParseManifest(newFakeDiscovery(), string(content), "")
+	if err != nil {
+		t.Fatalf("ParseManifest() of the exported yaml returned error: %s", err)
+	}
+	if len(parsed) != len(objects) {
+		t.Errorf("exported yaml contains %d objects, expected %d", len(parsed), len(objects))
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTarGz(&buf, objects); err != nil {
+		t.Fatalf("WriteTarGz() returned error: %s", err)
+	}
+
+	gr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("reading gzip: %s", err)
+	}
+	tr := tar.NewReader(gr)
+	for _, obj := range objects {
+		header, err := tr.Next()
+		if err != nil {
+			t.Fatalf("reading tar: %s", err)
+		}
+		if header.Name != ObjectFilename(obj) {
+			t.Errorf("tar entry %q, expected %q", header.Name, ObjectFilename(obj))
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("reading tar entry: %s", err)
+		}
+		file := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(data, &file.Object); err != nil {
+			t.Fatalf("decoding tar entry %q: %s", header.Name, err)
+		}
+		if file.GetName() != obj.GetName() {
+			t.Errorf("tar entry %q contains object %q", header.Name, file.GetName())
+		}
+	}
+	if _, err := tr.Next(); err != io.EOF {
+		t.Errorf("expected %d tar entries", len(objects))
+	}
+}
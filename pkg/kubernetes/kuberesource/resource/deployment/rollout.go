@@ -0,0 +1,311 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	client "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// RevisionAnnotation is the revision annotation of a deployment's replica sets which records its rollout sequence.
+	RevisionAnnotation = "deployment.kubernetes.io/revision"
+	// ChangeCauseAnnotation is the annotation that records the cause of a change.
+	ChangeCauseAnnotation = "kubernetes.io/change-cause"
+
+	// TimedOutReason is added in a deployment when its newest replica set fails to show any progress
+	// within the given deadline (progressDeadlineSeconds).
+	TimedOutReason = "ProgressDeadlineExceeded"
+)
+
+// RolloutStatus is the rollout progress of a deployment.
+type RolloutStatus struct {
+	// Revision of the deployment.
+	Revision string `json:"revision"`
+
+	// Whether the rollout has completed.
+	Done bool `json:"done"`
+
+	// Whether the rollout has exceeded its progress deadline.
+	Failed bool `json:"failed"`
+
+	// Human readable description of the rollout progress.
+	Message string `json:"message"`
+
+	// Replica counts of the deployment.
+	StatusInfo StatusInfo `json:"statusInfo"`
+
+	// Name of the replica set that has the current pod template, empty if it has not been created yet.
+	NewReplicaSet string `json:"newReplicaSet"`
+
+	// Names of the replica sets that still have pods of previous pod templates.
+	OldReplicaSets []string `json:"oldReplicaSets"`
+}
+
+// Revision is a revision of a deployment, it is recorded by one of its replica sets.
+type Revision struct {
+	// Revision number.
+	Revision int64 `json:"revision"`
+
+	// Name of the replica set that records the revision.
+	ReplicaSet string `json:"replicaSet"`
+
+	// Container images of the revision.
+	ContainerImages []string `json:"containerImages"`
+
+	// Cause of the change, taken from the kubernetes.io/change-cause annotation.
+	ChangeCause string `json:"changeCause"`
+
+	// Number of replicas of the replica set.
+	Replicas int32 `json:"replicas"`
+
+	// Creation time of the revision.
+	CreationTimestamp metaV1.Time `json:"creationTimestamp"`
+
+	// Whether the revision is the current one.
+	Current bool `json:"current"`
+}
+
+// UpdateContainerImage sets the image of the named container of the deployment, which triggers a rolling update.
+func UpdateContainerImage(client client.Interface, namespace, name, container, image string) (*apps.Deployment, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !setContainerImage(deployment.Spec.Template.Spec.Containers, container, image) &&
+		!setContainerImage(deployment.Spec.Template.Spec.InitContainers, container, image) {
+		return nil, fmt.Errorf("container %s not found in deployment %s", container, name)
+	}
+
+	return client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
+}
+
+func setContainerImage(containers []v1.Container, name, image string) bool {
+	for i := range containers {
+		if containers[i].Name == name {
+			containers[i].Image = image
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetRolloutStatus returns the rollout progress of the deployment. The logic follows 'kubectl rollout status'.
+func GetRolloutStatus(client client.Interface, namespace, name string) (*RolloutStatus, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rsList, err := getDeploymentReplicaSets(client, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &RolloutStatus{
+		Revision:       deployment.Annotations[RevisionAnnotation],
+		StatusInfo:     GetStatusInfo(&deployment.Status),
+		OldReplicaSets: make([]string, 0),
+	}
+
+	if newRS := FindNewReplicaSet(deployment, rsList); newRS != nil {
+		status.NewReplicaSet = newRS.Name
+	}
+	oldRSs, _, _ := FindOldReplicaSets(deployment, rsList)
+	for _, rs := range oldRSs {
+		status.OldReplicaSets = append(status.OldReplicaSets, rs.Name)
+	}
+
+	status.Done, status.Failed, status.Message = rolloutProgress(deployment)
+	return status, nil
+}
+
+func rolloutProgress(deployment *apps.Deployment) (done, failed bool, message string) {
+	if deployment.Generation > deployment.Status.ObservedGeneration {
+		return false, false, "Waiting for deployment spec update to be observed"
+	}
+
+	for _, condition := range deployment.Status.Conditions {
+		if condition.Type == apps.DeploymentProgressing && condition.Reason == TimedOutReason {
+			return false, true, fmt.Sprintf("Deployment %q exceeded its progress deadline", deployment.Name)
+		}
+	}
+
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+
+	if status.UpdatedReplicas < replicas {
+		return false, false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d out of %d new replicas have been updated",
+			deployment.Name, status.UpdatedReplicas, replicas)
+	}
+	if status.Replicas > status.UpdatedReplicas {
+		return false, false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d old replicas are pending termination",
+			deployment.Name, status.Replicas-status.UpdatedReplicas)
+	}
+	if status.AvailableReplicas < status.UpdatedReplicas {
+		return false, false, fmt.Sprintf("Waiting for deployment %q rollout to finish: %d of %d updated replicas are available",
+			deployment.Name, status.AvailableReplicas, status.UpdatedReplicas)
+	}
+
+	return true, false, fmt.Sprintf("Deployment %q successfully rolled out", deployment.Name)
+}
+
+// GetRevisionHistory returns the revisions of the deployment sorted from the newest to the oldest.
+func GetRevisionHistory(client client.Interface, namespace, name string) ([]Revision, error) {
+	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	rsList, err := getDeploymentReplicaSets(client, deployment)
+	if err != nil {
+		return nil, err
+	}
+
+	current := deployment.Annotations[RevisionAnnotation]
+	revisions := make([]Revision, 0, len(rsList))
+	for _, rs := range rsList {
+		revision, err := strconv.ParseInt(rs.Annotations[RevisionAnnotation], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		replicas := int32(0)
+		if rs.Spec.Replicas != nil {
+			replicas = *rs.Spec.Replicas
+		}
+
+		revisions = append(revisions, Revision{
+			Revision:          revision,
+			ReplicaSet:        rs.Name,
+			ContainerImages:   containerImages(rs.Spec.Template.Spec),
+			ChangeCause:       rs.Annotations[ChangeCauseAnnotation],
+			Replicas:          replicas,
+			CreationTimestamp: rs.CreationTimestamp,
+			Current:           rs.Annotations[RevisionAnnotation] == current,
+		})
+	}
+
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision > revisions[j].Revision
+	})
+
+	return revisions, nil
+}
+
+// Rollback rolls the deployment back to the given revision. The highest revision below the current one is used
+// when revision is 0.
+func Rollback(client client.Interface, namespace, name string, revision int64) (*apps.Deployment, error) {
+	revisions, err := GetRevisionHistory(client, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+
+	current := int64(-1)
+	for _, r := range revisions {
+		if r.Current {
+			current = r.Revision
+			break
+		}
+	}
+
+	target := ""
+	if revision == 0 {
+		// Revisions are sorted from the newest to the oldest.
+		for _, r := range revisions {
+			if r.Revision < current {
+				target = r.ReplicaSet
+				break
+			}
+		}
+		if target == "" {
+			return nil, fmt.Errorf("no previous revision found for deployment %s", name)
+		}
+	} else {
+		if revision == current {
+			return nil, fmt.Errorf("revision %d is already the current revision of deployment %s", revision, name)
+		}
+		for _, r := range revisions {
+			if r.Revision == revision {
+				target = r.ReplicaSet
+				break
+			}
+		}
+		if target == "" {
+			return nil, fmt.Errorf("revision %d not found for deployment %s", revision, name)
+		}
+	}
+
+	rs, err := client.AppsV1().ReplicaSets(namespace).Get(context.TODO(), target, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// The replica set template carries the pod-template-hash label which is added by the deployment controller.
+	template := rs.Spec.Template.DeepCopy()
+	delete(template.Labels, apps.DefaultDeploymentUniqueLabelKey)
+	deployment.Spec.Template = *template
+
+	return client.AppsV1().Deployments(namespace).Update(context.TODO(), deployment, metaV1.UpdateOptions{})
+}
+
+// Returns the replica sets controlled by the deployment.
+func getDeploymentReplicaSets(client client.Interface, deployment *apps.Deployment) ([]*apps.ReplicaSet, error) {
+	selector, err := metaV1.LabelSelectorAsSelector(deployment.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	rsList, err := client.AppsV1().ReplicaSets(deployment.Namespace).List(context.TODO(),
+		metaV1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*apps.ReplicaSet, 0, len(rsList.Items))
+	for i := range rsList.Items {
+		if ref := metaV1.GetControllerOf(&rsList.Items[i]); ref != nil && ref.UID == deployment.UID {
+			result = append(result, &rsList.Items[i])
+		}
+	}
+
+	return result, nil
+}
+
+func containerImages(spec v1.PodSpec) []string {
+	images := make([]string, 0, len(spec.Containers))
+	for _, container := range spec.Containers {
+		images = append(images, container.Image)
+	}
+
+	return images
+}
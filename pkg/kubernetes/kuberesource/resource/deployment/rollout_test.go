@@ -0,0 +1,200 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newRolloutDeployment(image, revision string) *apps.Deployment {
+	replicas := int32(2)
+	return &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        "web",
+			Namespace:   "ns",
+			UID:         types.UID("web-uid"),
+			Generation:  2,
+			Annotations: map[string]string{RevisionAnnotation: revision},
+		},
+		Spec: apps.DeploymentSpec{
+			Replicas: &replicas,
+			Selector: &metaV1.LabelSelector{MatchLabels: map[string]string{"app": "web"}},
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metaV1.ObjectMeta{Labels: map[string]string{"app": "web"}},
+				Spec:       v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: image}}},
+			},
+		},
+		Status: apps.DeploymentStatus{
+			ObservedGeneration: 2,
+			Replicas:           2,
+			UpdatedReplicas:    2,
+			AvailableReplicas:  2,
+		},
+	}
+}
+
+func newRolloutReplicaSet(name, image, revision string, replicas int32) *apps.ReplicaSet {
+	controller := true
+	return &apps.ReplicaSet{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:        name,
+			Namespace:   "ns",
+			UID:         types.UID(name + "-uid"),
+			Labels:      map[string]string{"app": "web"},
+			Annotations: map[string]string{RevisionAnnotation: revision},
+			OwnerReferences: []metaV1.OwnerReference{{
+				Kind:       "Deployment",
+				Name:       "web",
+				UID:        types.UID("web-uid"),
+				Controller: &controller,
+			}},
+		},
+		Spec: apps.ReplicaSetSpec{
+			Replicas: &replicas,
+			Template: v1.PodTemplateSpec{
+				ObjectMeta: metaV1.ObjectMeta{Labels: map[string]string{
+					"app":                                "web",
+					apps.DefaultDeploymentUniqueLabelKey: name,
+				}},
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "web", Image: image}}},
+			},
+		},
+	}
+}
+
+func TestUpdateContainerImage(t *testing.T) {
+	client := fake.NewSimpleClientset(newRolloutDeployment("nginx:1.0", "1"))
+
+	deployment, err := UpdateContainerImage(client, "ns", "web", "web", "nginx:2.0")
+	if err != nil {
+		t.Fatalf("UpdateContainerImage(): unexpected error: %v", err)
+	}
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "nginx:2.0" {
+		t.Errorf("UpdateContainerImage(): expected image nginx:2.0, got %s", image)
+	}
+
+	if _, err := UpdateContainerImage(client, "ns", "web", "missing", "nginx:2.0"); err == nil {
+		t.Errorf("UpdateContainerImage(): expected error for unknown container")
+	}
+}
+
+func TestGetRolloutStatus(t *testing.T) {
+	deployment := newRolloutDeployment("nginx:2.0", "2")
+	deployment.Status.UpdatedReplicas = 1
+	client := fake.NewSimpleClientset(deployment,
+		newRolloutReplicaSet("web-1", "nginx:1.0", "1", 1),
+		newRolloutReplicaSet("web-2", "nginx:2.0", "2", 1))
+
+	status, err := GetRolloutStatus(client, "ns", "web")
+	if err != nil {
+		t.Fatalf("GetRolloutStatus(): unexpected error: %v", err)
+	}
+
+	if status.Done || status.Failed {
+		t.Errorf("GetRolloutStatus(): expected rollout in progress, got %#v", status)
+	}
+	if status.NewReplicaSet != "web-2" {
+		t.Errorf("GetRolloutStatus(): expected new replica set web-2, got %s", status.NewReplicaSet)
+	}
+	if !reflect.DeepEqual(status.OldReplicaSets, []string{"web-1"}) {
+		t.Errorf("GetRolloutStatus(): expected old replica sets [web-1], got %v", status.OldReplicaSets)
+	}
+}
+
+func TestRolloutProgress(t *testing.T) {
+	cases := []struct {
+		info         string
+		mutate       func(d *apps.Deployment)
+		done, failed bool
+	}{
+		{"complete", func(d *apps.Deployment) {}, true, false},
+		{"not observed", func(d *apps.Deployment) { d.Status.ObservedGeneration = 1 }, false, false},
+		{"old replicas", func(d *apps.Deployment) { d.Status.Replicas = 3 }, false, false},
+		{"unavailable", func(d *apps.Deployment) { d.Status.AvailableReplicas = 1 }, false, false},
+		{"timed out", func(d *apps.Deployment) {
+			d.Status.Conditions = []apps.DeploymentCondition{{Type: apps.DeploymentProgressing, Reason: TimedOutReason}}
+		}, false, true},
+	}
+
+	for _, c := range cases {
+		deployment := newRolloutDeployment("nginx:1.0", "1")
+		c.mutate(deployment)
+		done, failed, _ := rolloutProgress(deployment)
+		if done != c.done || failed != c.failed {
+			t.Errorf("rolloutProgress(%s): expected done %v failed %v, got %v %v", c.info, c.done, c.failed, done, failed)
+		}
+	}
+}
+
+func TestRollback(t *testing.T) {
+	client := fake.NewSimpleClientset(newRolloutDeployment("nginx:2.0", "2"),
+		newRolloutReplicaSet("web-1", "nginx:1.0", "1", 0),
+		newRolloutReplicaSet("web-2", "nginx:2.0", "2", 2))
+
+	revisions, err := GetRevisionHistory(client, "ns", "web")
+	if err != nil {
+		t.Fatalf("GetRevisionHistory(): unexpected error: %v", err)
+	}
+	if len(revisions) != 2 || revisions[0].Revision != 2 || !revisions[0].Current || revisions[1].Current {
+		t.Fatalf("GetRevisionHistory(): unexpected revisions %#v", revisions)
+	}
+
+	deployment, err := Rollback(client, "ns", "web", 0)
+	if err != nil {
+		t.Fatalf("Rollback(): unexpected error: %v", err)
+	}
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "nginx:1.0" {
+		t.Errorf("Rollback(): expected image nginx:1.0, got %s", image)
+	}
+	if _, ok := deployment.Spec.Template.Labels[apps.DefaultDeploymentUniqueLabelKey]; ok {
+		t.Errorf("Rollback(): expected pod-template-hash label to be removed")
+	}
+
+	if _, err := Rollback(client, "ns", "web", 5); err == nil {
+		t.Errorf("Rollback(): expected error for unknown revision")
+	}
+	if _, err := Rollback(client, "ns", "web", 2); err == nil {
+		t.Errorf("Rollback(): expected error for the current revision")
+	}
+}
+
+func TestRollbackToPreviousRevision(t *testing.T) {
+	// Revision 3 is newer than the current revision, the previous revision of the deployment is 1.
+	client := fake.NewSimpleClientset(newRolloutDeployment("nginx:2.0", "2"),
+		newRolloutReplicaSet("web-1", "nginx:1.0", "1", 0),
+		newRolloutReplicaSet("web-2", "nginx:2.0", "2", 2),
+		newRolloutReplicaSet("web-3", "nginx:3.0", "3", 0))
+
+	deployment, err := Rollback(client, "ns", "web", 0)
+	if err != nil {
+		t.Fatalf("Rollback(): unexpected error: %v", err)
+	}
+	if image := deployment.Spec.Template.Spec.Containers[0].Image; image != "nginx:1.0" {
+		t.Errorf("Rollback(): expected image nginx:1.0, got %s", image)
+	}
+
+	client = fake.NewSimpleClientset(newRolloutDeployment("nginx:1.0", "1"),
+		newRolloutReplicaSet("web-1", "nginx:1.0", "1", 2))
+	if _, err := Rollback(client, "ns", "web", 0); err == nil {
+		t.Errorf("Rollback(): expected error when there is no previous revision")
+	}
+}
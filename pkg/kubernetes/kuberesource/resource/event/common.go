@@ -147,7 +147,11 @@ func GetNodeEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQu
 
 // GetNamespaceEvents gets events associated to a namespace with given name.
 func GetNamespaceEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string) (common.EventList, error) {
-	events, _ := client.CoreV1().Events(namespace).List(context.TODO(), api.ListEverything)
+	events, err := client.CoreV1().Events(namespace).List(context.TODO(), api.ListEverything)
+	if err != nil {
+		return *EmptyEventList, err
+	}
+
 	return CreateEventList(FillEventsType(events.Items), dsQuery), nil
 }
 
@@ -0,0 +1,96 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"context"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
+
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	watchtools "k8s.io/client-go/tools/watch"
+)
+
+// EventFilter selects the events of a namespace by the object they are about and by their type.
+// Empty fields match all events.
+type EventFilter struct {
+	// Kind of the involved object, e.g. Deployment.
+	Kind string
+
+	// Name of the involved object.
+	Name string
+
+	// Type of the event, Normal or Warning.
+	Type string
+}
+
+// FieldSelector returns the field selector of the events that match the filter.
+func (f EventFilter) FieldSelector() fields.Selector {
+	set := fields.Set{}
+	if f.Kind != "" {
+		set["involvedObject.kind"] = f.Kind
+	}
+	if f.Name != "" {
+		set["involvedObject.name"] = f.Name
+	}
+	if f.Type != "" {
+		set["type"] = f.Type
+	}
+
+	return fields.SelectorFromSet(set)
+}
+
+// GetFilteredEvents gets the events of the namespace that match the filter.
+func GetFilteredEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace string,
+	filter EventFilter) (*common.EventList, error) {
+
+	events, err := client.CoreV1().Events(namespace).List(context.TODO(), metaV1.ListOptions{
+		FieldSelector: filter.FieldSelector().String(),
+	})
+	if err != nil {
+		return EmptyEventList, err
+	}
+
+	eventList := CreateEventList(FillEventsType(events.Items), dsQuery)
+	return &eventList, nil
+}
+
+// WatchEvents watches the events of the namespace that match the filter. Only the events that are
+// created or updated after the call are sent, the watch is resumed when the connection to the
+// apiserver is interrupted.
+func WatchEvents(client kubernetes.Interface, namespace string, filter EventFilter) (watch.Interface, error) {
+	selector := filter.FieldSelector().String()
+
+	// A paginated list is served by the apiserver and returns the current resource version.
+	list, err := client.CoreV1().Events(namespace).List(context.TODO(), metaV1.ListOptions{
+		FieldSelector: selector,
+		Limit:         1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return watchtools.NewRetryWatcher(list.ResourceVersion, &cache.ListWatch{
+		WatchFunc: func(options metaV1.ListOptions) (watch.Interface, error) {
+			options.FieldSelector = selector
+			return client.CoreV1().Events(namespace).Watch(context.TODO(), options)
+		},
+	})
+}
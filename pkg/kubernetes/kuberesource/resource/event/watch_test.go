@@ -0,0 +1,80 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package event
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func TestEventFilterFieldSelector(t *testing.T) {
+	cases := []struct {
+		filter   EventFilter
+		expected fields.Set
+	}{
+		{EventFilter{}, fields.Set{}},
+		{EventFilter{Type: v1.EventTypeWarning}, fields.Set{"type": "Warning"}},
+		{
+			EventFilter{Kind: "Deployment", Name: "web"},
+			fields.Set{"involvedObject.kind": "Deployment", "involvedObject.name": "web"},
+		},
+	}
+
+	for _, c := range cases {
+		actual := c.filter.FieldSelector()
+		if len(actual.Requirements()) != len(c.expected) || !actual.Matches(c.expected) {
+			t.Errorf("FieldSelector() of %#v == %s, expected %s", c.filter, actual, fields.SelectorFromSet(c.expected))
+		}
+	}
+}
+
+func TestWatchEvents(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	// The fake clientset does not set resource versions which the watch resumes from.
+	client.PrependReactor("list", "events", func(action core.Action) (bool, runtime.Object, error) {
+		return true, &v1.EventList{ListMeta: metaV1.ListMeta{ResourceVersion: "1"}}, nil
+	})
+	watcher := watch.NewFake()
+	client.PrependWatchReactor("events", core.DefaultWatchReactor(watcher, nil))
+
+	w, err := WatchEvents(client, "default", EventFilter{Type: v1.EventTypeWarning})
+	if err != nil {
+		t.Fatalf("WatchEvents() returned error: %s", err)
+	}
+	defer w.Stop()
+
+	go watcher.Add(&v1.Event{
+		ObjectMeta: metaV1.ObjectMeta{Name: "web.1", Namespace: "default", ResourceVersion: "2"},
+		Reason:     "FailedCreate",
+		Type:       v1.EventTypeWarning,
+	})
+
+	select {
+	case e := <-w.ResultChan():
+		if e.Type != watch.Added || e.Object.(*v1.Event).Reason != "FailedCreate" {
+			t.Errorf("expected added event FailedCreate, got %s %#v", e.Type, e.Object)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the event")
+	}
+}
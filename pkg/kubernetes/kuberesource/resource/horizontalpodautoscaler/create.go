@@ -0,0 +1,185 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+const (
+	// KindDeployment and KindStatefulSet are the kinds of the workloads that can be autoscaled.
+	KindDeployment  = "Deployment"
+	KindStatefulSet = "StatefulSet"
+)
+
+// Spec is the desired autoscaling of a deployment or stateful set.
+type Spec struct {
+	// Workload that is scaled, the kind is Deployment or StatefulSet.
+	ScaleTargetRef ScaleTargetRef `json:"scaleTargetRef"`
+
+	// Lower limit of the number of pods, defaults to 1.
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// Upper limit of the number of pods.
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Target average CPU utilization in percent of the requested CPU of the pods.
+	TargetCPUUtilizationPercentage *int32 `json:"targetCPUUtilizationPercentage,omitempty"`
+
+	// Target average memory utilization in percent of the requested memory of the pods.
+	TargetMemoryUtilizationPercentage *int32 `json:"targetMemoryUtilizationPercentage,omitempty"`
+}
+
+// ValidateSpec checks the replica bounds and targets of the spec, and that the scaled workload
+// exists and requests the resources whose utilization is targeted, as utilization is computed
+// relative to the requests.
+func ValidateSpec(client k8sClient.Interface, namespace string, spec Spec) error {
+	if spec.MaxReplicas < 1 {
+		return errors.New("max replicas must be at least 1")
+	}
+	if spec.MinReplicas != nil && (*spec.MinReplicas < 1 || *spec.MinReplicas > spec.MaxReplicas) {
+		return fmt.Errorf("min replicas must be between 1 and max replicas %d", spec.MaxReplicas)
+	}
+
+	targets := utilizationTargets(spec)
+	for _, target := range targets {
+		if target.value < 1 {
+			return fmt.Errorf("target %s utilization must be a positive percentage", target.resource)
+		}
+	}
+	if len(targets) == 0 {
+		return errors.New("at least one of the cpu and memory utilization targets is required")
+	}
+
+	podSpec, err := getTargetPodSpec(client, namespace, spec.ScaleTargetRef)
+	if err != nil {
+		return err
+	}
+
+	for _, container := range podSpec.Containers {
+		for _, target := range targets {
+			if _, ok := container.Resources.Requests[target.resource]; !ok {
+				return fmt.Errorf("container %s of %s %s has no %s request", container.Name,
+					spec.ScaleTargetRef.Kind, spec.ScaleTargetRef.Name, target.resource)
+			}
+		}
+	}
+
+	return nil
+}
+
+func getTargetPodSpec(client k8sClient.Interface, namespace string, target ScaleTargetRef) (*v1.PodSpec, error) {
+	switch target.Kind {
+	case KindDeployment:
+		deployment, err := client.AppsV1().Deployments(namespace).Get(context.TODO(), target.Name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &deployment.Spec.Template.Spec, nil
+	case KindStatefulSet:
+		statefulSet, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), target.Name, metaV1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return &statefulSet.Spec.Template.Spec, nil
+	default:
+		return nil, fmt.Errorf("kind %s can not be autoscaled, expected %s or %s", target.Kind, KindDeployment, KindStatefulSet)
+	}
+}
+
+type utilizationTarget struct {
+	resource v1.ResourceName
+	value    int32
+}
+
+// Returns the utilization targets set in the spec, cpu first.
+func utilizationTargets(spec Spec) []utilizationTarget {
+	targets := make([]utilizationTarget, 0)
+	if spec.TargetCPUUtilizationPercentage != nil {
+		targets = append(targets, utilizationTarget{v1.ResourceCPU, *spec.TargetCPUUtilizationPercentage})
+	}
+	if spec.TargetMemoryUtilizationPercentage != nil {
+		targets = append(targets, utilizationTarget{v1.ResourceMemory, *spec.TargetMemoryUtilizationPercentage})
+	}
+
+	return targets
+}
+
+// Converts the spec to the spec of an autoscaling/v2beta2 horizontal pod autoscaler, which
+// supports memory targets unlike autoscaling/v1.
+func toAutoscalerSpec(spec Spec) autoscaling.HorizontalPodAutoscalerSpec {
+	result := autoscaling.HorizontalPodAutoscalerSpec{
+		ScaleTargetRef: autoscaling.CrossVersionObjectReference{
+			APIVersion: "apps/v1",
+			Kind:       spec.ScaleTargetRef.Kind,
+			Name:       spec.ScaleTargetRef.Name,
+		},
+		MinReplicas: spec.MinReplicas,
+		MaxReplicas: spec.MaxReplicas,
+	}
+
+	for _, target := range utilizationTargets(spec) {
+		utilization := target.value
+		result.Metrics = append(result.Metrics, autoscaling.MetricSpec{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name: target.resource,
+				Target: autoscaling.MetricTarget{
+					Type:               autoscaling.UtilizationMetricType,
+					AverageUtilization: &utilization,
+				},
+			},
+		})
+	}
+
+	return result
+}
+
+// CreateHorizontalPodAutoscaler creates a horizontal pod autoscaler with the given spec.
+func CreateHorizontalPodAutoscaler(client k8sClient.Interface, namespace, name string, spec Spec) (
+	*autoscaling.HorizontalPodAutoscaler, error) {
+	hpa := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: toAutoscalerSpec(spec),
+	}
+
+	return client.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Create(context.TODO(), hpa, metaV1.CreateOptions{})
+}
+
+// UpdateHorizontalPodAutoscaler replaces the scale target, replica bounds and metrics of the
+// horizontal pod autoscaler, the scaling behavior is kept.
+func UpdateHorizontalPodAutoscaler(client k8sClient.Interface, namespace, name string, spec Spec) (
+	*autoscaling.HorizontalPodAutoscaler, error) {
+	hpa, err := client.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	autoscalerSpec := toAutoscalerSpec(spec)
+	autoscalerSpec.Behavior = hpa.Spec.Behavior
+	hpa.Spec = autoscalerSpec
+
+	return client.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Update(context.TODO(), hpa, metaV1.UpdateOptions{})
+}
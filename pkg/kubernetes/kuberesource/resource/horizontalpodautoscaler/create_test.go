@@ -0,0 +1,128 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func getInt32Pointer(i int32) *int32 {
+	return &i
+}
+
+func newTestDeployment() *apps.Deployment {
+	return &apps.Deployment{
+		ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns-1"},
+		Spec: apps.DeploymentSpec{
+			Template: v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name: "nginx",
+				Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+					v1.ResourceCPU: resource.MustParse("100m"),
+				}},
+			}}}},
+		},
+	}
+}
+
+func TestValidateSpec(t *testing.T) {
+	web := ScaleTargetRef{Kind: KindDeployment, Name: "web"}
+	cases := []struct {
+		spec  Spec
+		valid bool
+	}{
+		{Spec{ScaleTargetRef: web, MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, true},
+		{Spec{ScaleTargetRef: web, MinReplicas: getInt32Pointer(2), MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, true},
+		{Spec{ScaleTargetRef: web, MaxReplicas: 0, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, false},
+		{Spec{ScaleTargetRef: web, MinReplicas: getInt32Pointer(6), MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, false},
+		{Spec{ScaleTargetRef: web, MaxReplicas: 5}, false},
+		{Spec{ScaleTargetRef: web, MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(0)}, false},
+		{Spec{ScaleTargetRef: web, MaxReplicas: 5, TargetMemoryUtilizationPercentage: getInt32Pointer(80)}, false},
+		{Spec{ScaleTargetRef: ScaleTargetRef{Kind: KindDeployment, Name: "db"}, MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, false},
+		{Spec{ScaleTargetRef: ScaleTargetRef{Kind: "DaemonSet", Name: "web"}, MaxReplicas: 5, TargetCPUUtilizationPercentage: getInt32Pointer(80)}, false},
+	}
+
+	for _, c := range cases {
+		err := ValidateSpec(fake.NewSimpleClientset(newTestDeployment()), "ns-1", c.spec)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateSpec(%#v) returned %v, expected valid %v", c.spec, err, c.valid)
+		}
+	}
+}
+
+func TestCreateAndUpdateHorizontalPodAutoscaler(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	spec := Spec{
+		ScaleTargetRef:                    ScaleTargetRef{Kind: KindDeployment, Name: "web"},
+		MaxReplicas:                       5,
+		TargetCPUUtilizationPercentage:    getInt32Pointer(80),
+		TargetMemoryUtilizationPercentage: getInt32Pointer(70),
+	}
+
+	created, err := CreateHorizontalPodAutoscaler(client, "ns-1", "web", spec)
+	if err != nil {
+		t.Fatalf("CreateHorizontalPodAutoscaler() returned error: %s", err)
+	}
+
+	expected := []autoscaling.MetricSpec{
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name:   v1.ResourceCPU,
+				Target: autoscaling.MetricTarget{Type: autoscaling.UtilizationMetricType, AverageUtilization: getInt32Pointer(80)},
+			},
+		},
+		{
+			Type: autoscaling.ResourceMetricSourceType,
+			Resource: &autoscaling.ResourceMetricSource{
+				Name:   v1.ResourceMemory,
+				Target: autoscaling.MetricTarget{Type: autoscaling.UtilizationMetricType, AverageUtilization: getInt32Pointer(70)},
+			},
+		},
+	}
+	if !reflect.DeepEqual(created.Spec.Metrics, expected) {
+		t.Errorf("CreateHorizontalPodAutoscaler() set metrics %#v, expected %#v", created.Spec.Metrics, expected)
+	}
+
+	behavior := &autoscaling.HorizontalPodAutoscalerBehavior{
+		ScaleDown: &autoscaling.HPAScalingRules{StabilizationWindowSeconds: getInt32Pointer(60)},
+	}
+	created.Spec.Behavior = behavior
+	if _, err := client.AutoscalingV2beta2().HorizontalPodAutoscalers("ns-1").Update(context.TODO(), created, metaV1.UpdateOptions{}); err != nil {
+		t.Fatalf("Update() returned error: %s", err)
+	}
+
+	spec.MinReplicas = getInt32Pointer(2)
+	spec.TargetMemoryUtilizationPercentage = nil
+	updated, err := UpdateHorizontalPodAutoscaler(client, "ns-1", "web", spec)
+	if err != nil {
+		t.Fatalf("UpdateHorizontalPodAutoscaler() returned error: %s", err)
+	}
+
+	if *updated.Spec.MinReplicas != 2 || len(updated.Spec.Metrics) != 1 {
+		t.Errorf("UpdateHorizontalPodAutoscaler() set spec %#v", updated.Spec)
+	}
+	if !reflect.DeepEqual(updated.Spec.Behavior, behavior) {
+		t.Errorf("UpdateHorizontalPodAutoscaler() changed the behavior to %#v", updated.Spec.Behavior)
+	}
+}
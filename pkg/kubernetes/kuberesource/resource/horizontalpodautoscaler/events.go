@@ -0,0 +1,49 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"log"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/event"
+
+	v1 "k8s.io/api/core/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// GetHorizontalPodAutoscalerEvents returns the events of the horizontal pod autoscaler, e.g. the
+// rescales and failures to fetch the metrics.
+func GetHorizontalPodAutoscalerEvents(client k8sClient.Interface, dsQuery *dataselect.DataSelectQuery, namespace,
+	name string) (*common.EventList, error) {
+	log.Printf("Getting events related to %s horizontal pod autoscaler in %s namespace", name, namespace)
+
+	events, err := event.GetEvents(client, namespace, name)
+	if err != nil {
+		return event.EmptyEventList, err
+	}
+
+	// The autoscaler often has the name of the workload it scales, whose events are skipped.
+	hpaEvents := make([]v1.Event, 0)
+	for _, e := range events {
+		if e.InvolvedObject.Kind == "HorizontalPodAutoscaler" {
+			hpaEvents = append(hpaEvents, e)
+		}
+	}
+
+	eventList := event.CreateEventList(hpaEvents, dsQuery)
+	return &eventList, nil
+}
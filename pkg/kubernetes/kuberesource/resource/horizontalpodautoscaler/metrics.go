@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"context"
+	"fmt"
+
+	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// MetricStatus compares the current value of a metric of a horizontal pod autoscaler with its target.
+type MetricStatus struct {
+	// Type of the metric source, e.g. Resource or Pods.
+	Type autoscaling.MetricSourceType `json:"type"`
+
+	// Name of the resource or metric, e.g. cpu.
+	Name string `json:"name"`
+
+	// Target of the metric, e.g. 80% for a utilization or 500Mi for an average value.
+	Target string `json:"target"`
+
+	// Current value of the metric in the same unit as the target, empty until the metric is known.
+	Current string `json:"current"`
+}
+
+// GetHorizontalPodAutoscalerMetrics returns the current and target values of the metrics of the
+// horizontal pod autoscaler.
+func GetHorizontalPodAutoscalerMetrics(client k8sClient.Interface, namespace, name string) ([]MetricStatus, error) {
+	hpa, err := client.AutoscalingV2beta2().HorizontalPodAutoscalers(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return toMetricStatuses(hpa), nil
+}
+
+func toMetricStatuses(hpa *autoscaling.HorizontalPodAutoscaler) []MetricStatus {
+	result := make([]MetricStatus, 0, len(hpa.Spec.Metrics))
+	for _, metric := range hpa.Spec.Metrics {
+		name, target := metricSpecTarget(metric)
+		status := MetricStatus{
+			Type:   metric.Type,
+			Name:   name,
+			Target: formatTarget(target),
+		}
+
+		for _, current := range hpa.Status.CurrentMetrics {
+			if currentName, value := metricStatusValue(current); current.Type == metric.Type && currentName == name {
+				status.Current = formatValue(target.Type, value)
+				break
+			}
+		}
+
+		result = append(result, status)
+	}
+
+	return result
+}
+
+func metricSpecTarget(metric autoscaling.MetricSpec) (string, autoscaling.MetricTarget) {
+	switch {
+	case metric.Resource != nil:
+		return string(metric.Resource.Name), metric.Resource.Target
+	case metric.Pods != nil:
+		return metric.Pods.Metric.Name, metric.Pods.Target
+	case metric.Object != nil:
+		return metric.Object.Metric.Name, metric.Object.Target
+	case metric.External != nil:
+		return metric.External.Metric.Name, metric.External.Target
+	default:
+		return "", autoscaling.MetricTarget{}
+	}
+}
+
+func metricStatusValue(metric autoscaling.MetricStatus) (string, autoscaling.MetricValueStatus) {
+	switch {
+	case metric.Resource != nil:
+		return string(metric.Resource.Name), metric.Resource.Current
+	case metric.Pods != nil:
+		return metric.Pods.Metric.Name, metric.Pods.Current
+	case metric.Object != nil:
+		return metric.Object.Metric.Name, metric.Object.Current
+	case metric.External != nil:
+		return metric.External.Metric.Name, metric.External.Current
+	default:
+		return "", autoscaling.MetricValueStatus{}
+	}
+}
+
+func formatTarget(target autoscaling.MetricTarget) string {
+	return formatValue(target.Type, autoscaling.MetricValueStatus{
+		Value:              target.Value,
+		AverageValue:       target.AverageValue,
+		AverageUtilization: target.AverageUtilization,
+	})
+}
+
+// Formats the value matching the target type, so that the current value and the target can be compared.
+func formatValue(targetType autoscaling.MetricTargetType, value autoscaling.MetricValueStatus) string {
+	switch {
+	case targetType == autoscaling.UtilizationMetricType && value.AverageUtilization != nil:
+		return fmt.Sprintf("%d%%", *value.AverageUtilization)
+	case targetType == autoscaling.AverageValueMetricType && value.AverageValue != nil:
+		return value.AverageValue.String()
+	case targetType == autoscaling.ValueMetricType && value.Value != nil:
+		return value.Value.String()
+	default:
+		return ""
+	}
+}
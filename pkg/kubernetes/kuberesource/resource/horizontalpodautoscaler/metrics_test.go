@@ -0,0 +1,92 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package horizontalpodautoscaler
+
+import (
+	"reflect"
+	"testing"
+
+	autoscaling "k8s.io/api/autoscaling/v2beta2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetHorizontalPodAutoscalerMetrics(t *testing.T) {
+	averageValue := resource.MustParse("100")
+	currentValue := resource.MustParse("150")
+	hpa := &autoscaling.HorizontalPodAutoscaler{
+		ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns-1"},
+		Spec: autoscaling.HorizontalPodAutoscalerSpec{
+			MaxReplicas: 5,
+			Metrics: []autoscaling.MetricSpec{
+				{
+					Type: autoscaling.ResourceMetricSourceType,
+					Resource: &autoscaling.ResourceMetricSource{
+						Name:   v1.ResourceCPU,
+						Target: autoscaling.MetricTarget{Type: autoscaling.UtilizationMetricType, AverageUtilization: getInt32Pointer(80)},
+					},
+				},
+				{
+					Type: autoscaling.ResourceMetricSourceType,
+					Resource: &autoscaling.ResourceMetricSource{
+						Name:   v1.ResourceMemory,
+						Target: autoscaling.MetricTarget{Type: autoscaling.UtilizationMetricType, AverageUtilization: getInt32Pointer(70)},
+					},
+				},
+				{
+					Type: autoscaling.PodsMetricSourceType,
+					Pods: &autoscaling.PodsMetricSource{
+						Metric: autoscaling.MetricIdentifier{Name: "requests_per_second"},
+						Target: autoscaling.MetricTarget{Type: autoscaling.AverageValueMetricType, AverageValue: &averageValue},
+					},
+				},
+			},
+		},
+		Status: autoscaling.HorizontalPodAutoscalerStatus{
+			CurrentMetrics: []autoscaling.MetricStatus{
+				{
+					Type: autoscaling.PodsMetricSourceType,
+					Pods: &autoscaling.PodsMetricStatus{
+						Metric:  autoscaling.MetricIdentifier{Name: "requests_per_second"},
+						Current: autoscaling.MetricValueStatus{AverageValue: &currentValue},
+					},
+				},
+				{
+					Type: autoscaling.ResourceMetricSourceType,
+					Resource: &autoscaling.ResourceMetricStatus{
+						Name:    v1.ResourceCPU,
+						Current: autoscaling.MetricValueStatus{AverageUtilization: getInt32Pointer(95)},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []MetricStatus{
+		{Type: autoscaling.ResourceMetricSourceType, Name: "cpu", Target: "80%", Current: "95%"},
+		{Type: autoscaling.ResourceMetricSourceType, Name: "memory", Target: "70%"},
+		{Type: autoscaling.PodsMetricSourceType, Name: "requests_per_second", Target: "100", Current: "150"},
+	}
+
+	actual, err := GetHorizontalPodAutoscalerMetrics(fake.NewSimpleClientset(hpa), "ns-1", "web")
+	if err != nil {
+		t.Fatalf("GetHorizontalPodAutoscalerMetrics() returned error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetHorizontalPodAutoscalerMetrics() == \ngot %#v, \nexpected %#v", actual, expected)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// RuleSpec maps a host and path to a port of a backend service.
+type RuleSpec struct {
+	// Host matched by the rule, all hosts when it is empty.
+	Host string `json:"host"`
+
+	// Path matched by the rule, all paths when it is empty.
+	Path string `json:"path"`
+
+	// PathType is one of Exact, Prefix or ImplementationSpecific, defaults to the ingress
+	// controller behaviour when it is empty.
+	PathType *extensions.PathType `json:"pathType,omitempty"`
+
+	// Name of the backend service.
+	ServiceName string `json:"serviceName"`
+
+	// Port number or name of the backend service.
+	ServicePort intstr.IntOrString `json:"servicePort" swaggertype:"string"`
+}
+
+// TLSSpec terminates TLS for the hosts with the certificate of a secret.
+type TLSSpec struct {
+	// Hosts covered by the certificate.
+	Hosts []string `json:"hosts"`
+
+	// Name of the kubernetes.io/tls secret in the namespace of the ingress.
+	SecretName string `json:"secretName"`
+}
+
+// Spec is the desired routing of an ingress.
+type Spec struct {
+	// Name of the ingress class, the default class of the cluster is used when it is nil.
+	IngressClassName *string `json:"ingressClassName,omitempty"`
+
+	// Rules of the ingress, at least one is required.
+	Rules []RuleSpec `json:"rules"`
+
+	// TLS configuration of the ingress.
+	TLS []TLSSpec `json:"tls"`
+}
+
+// NewIngressSpec converts the spec to an ingress spec, rules with the same host are merged
+// in the order they are given.
+func NewIngressSpec(spec Spec) (extensions.IngressSpec, error) {
+	result := extensions.IngressSpec{
+		IngressClassName: spec.IngressClassName,
+	}
+
+	if len(spec.Rules) == 0 {
+		return result, errors.New("at least one rule is required")
+	}
+
+	hosts := make(map[string]int)
+	for _, rule := range spec.Rules {
+		if rule.ServiceName == "" || (rule.ServicePort.Type == intstr.Int && rule.ServicePort.IntVal == 0) ||
+			(rule.ServicePort.Type == intstr.String && rule.ServicePort.StrVal == "") {
+			return result, fmt.Errorf("rule %s%s has no backend service port", rule.Host, rule.Path)
+		}
+
+		i, ok := hosts[rule.Host]
+		if !ok {
+			i = len(result.Rules)
+			hosts[rule.Host] = i
+			result.Rules = append(result.Rules, extensions.IngressRule{
+				Host: rule.Host,
+				IngressRuleValue: extensions.IngressRuleValue{
+					HTTP: &extensions.HTTPIngressRuleValue{},
+				},
+			})
+		}
+
+		http := result.Rules[i].HTTP
+		http.Paths = append(http.Paths, extensions.HTTPIngressPath{
+			Path:     rule.Path,
+			PathType: rule.PathType,
+			Backend: extensions.IngressBackend{
+				ServiceName: rule.ServiceName,
+				ServicePort: rule.ServicePort,
+			},
+		})
+	}
+
+	for _, tls := range spec.TLS {
+		if tls.SecretName == "" {
+			return result, errors.New("tls secret name is required")
+		}
+		result.TLS = append(result.TLS, extensions.IngressTLS{
+			Hosts:      tls.Hosts,
+			SecretName: tls.SecretName,
+		})
+	}
+
+	return result, nil
+}
+
+// CreateIngress creates an ingress with the given spec.
+func CreateIngress(client client.Interface, namespace, name string, spec Spec) (*extensions.Ingress, error) {
+	ingressSpec, err := NewIngressSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: ingressSpec,
+	}
+
+	return client.ExtensionsV1beta1().Ingresses(namespace).Create(context.TODO(), ingress, metaV1.CreateOptions{})
+}
+
+// UpdateIngress replaces the rules, TLS configuration and class of the ingress, the default
+// backend and the metadata are kept.
+func UpdateIngress(client client.Interface, namespace, name string, spec Spec) (*extensions.Ingress, error) {
+	ingressSpec, err := NewIngressSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	ingress, err := client.ExtensionsV1beta1().Ingresses(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	ingress.Spec.IngressClassName = ingressSpec.IngressClassName
+	ingress.Spec.Rules = ingressSpec.Rules
+	ingress.Spec.TLS = ingressSpec.TLS
+
+	return client.ExtensionsV1beta1().Ingresses(namespace).Update(context.TODO(), ingress, metaV1.UpdateOptions{})
+}
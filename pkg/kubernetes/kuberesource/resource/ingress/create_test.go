@@ -0,0 +1,121 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewIngressSpec(t *testing.T) {
+	class := "nginx"
+	spec := Spec{
+		IngressClassName: &class,
+		Rules: []RuleSpec{
+			{Host: "shop.example.com", Path: "/", ServiceName: "web", ServicePort: intstr.FromInt(80)},
+			{Host: "api.example.com", Path: "/", ServiceName: "api", ServicePort: intstr.FromString("http")},
+			{Host: "shop.example.com", Path: "/static", ServiceName: "static", ServicePort: intstr.FromInt(8080)},
+		},
+		TLS: []TLSSpec{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+	}
+
+	expected := extensions.IngressSpec{
+		IngressClassName: &class,
+		Rules: []extensions.IngressRule{
+			{
+				Host: "shop.example.com",
+				IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: []extensions.HTTPIngressPath{
+						{Path: "/", Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)}},
+						{Path: "/static", Backend: extensions.IngressBackend{ServiceName: "static", ServicePort: intstr.FromInt(8080)}},
+					},
+				}},
+			},
+			{
+				Host: "api.example.com",
+				IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+					Paths: []extensions.HTTPIngressPath{
+						{Path: "/", Backend: extensions.IngressBackend{ServiceName: "api", ServicePort: intstr.FromString("http")}},
+					},
+				}},
+			},
+		},
+		TLS: []extensions.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+	}
+
+	actual, err := NewIngressSpec(spec)
+	if err != nil {
+		t.Fatalf("NewIngressSpec(%#v) returned error: %s", spec, err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("NewIngressSpec(%#v) == \ngot %#v, \nexpected %#v", spec, actual, expected)
+	}
+}
+
+func TestNewIngressSpecErrors(t *testing.T) {
+	cases := []Spec{
+		{},
+		{Rules: []RuleSpec{{Host: "a.example.com", ServicePort: intstr.FromInt(80)}}},
+		{Rules: []RuleSpec{{Host: "a.example.com", ServiceName: "web"}}},
+		{Rules: []RuleSpec{{Host: "a.example.com", ServiceName: "web", ServicePort: intstr.FromString("")}}},
+		{
+			Rules: []RuleSpec{{Host: "a.example.com", ServiceName: "web", ServicePort: intstr.FromInt(80)}},
+			TLS:   []TLSSpec{{Hosts: []string{"a.example.com"}}},
+		},
+	}
+
+	for _, c := range cases {
+		if _, err := NewIngressSpec(c); err == nil {
+			t.Errorf("NewIngressSpec(%#v) expected an error", c)
+		}
+	}
+}
+
+func TestUpdateIngress(t *testing.T) {
+	defaultBackend := &extensions.IngressBackend{ServiceName: "default", ServicePort: intstr.FromInt(80)}
+	ingress := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{Name: "shop", Namespace: "ns-1", Labels: map[string]string{"app": "shop"}},
+		Spec: extensions.IngressSpec{
+			Backend: defaultBackend,
+			Rules:   []extensions.IngressRule{{Host: "old.example.com"}},
+			TLS:     []extensions.IngressTLS{{SecretName: "old-tls"}},
+		},
+	}
+	client := fake.NewSimpleClientset(ingress)
+
+	spec := Spec{Rules: []RuleSpec{{Host: "shop.example.com", ServiceName: "web", ServicePort: intstr.FromInt(80)}}}
+	updated, err := UpdateIngress(client, "ns-1", "shop", spec)
+	if err != nil {
+		t.Fatalf("UpdateIngress() returned error: %s", err)
+	}
+
+	if !reflect.DeepEqual(updated.Spec.Backend, defaultBackend) {
+		t.Errorf("UpdateIngress() changed the default backend to %#v", updated.Spec.Backend)
+	}
+	if updated.Labels["app"] != "shop" {
+		t.Errorf("UpdateIngress() dropped the labels, got %#v", updated.Labels)
+	}
+	if len(updated.Spec.Rules) != 1 || updated.Spec.Rules[0].Host != "shop.example.com" {
+		t.Errorf("UpdateIngress() set rules %#v", updated.Spec.Rules)
+	}
+	if len(updated.Spec.TLS) != 0 {
+		t.Errorf("UpdateIngress() kept tls %#v", updated.Spec.TLS)
+	}
+}
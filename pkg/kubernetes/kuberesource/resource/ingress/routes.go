@@ -0,0 +1,190 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/api"
+
+	v1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	client "k8s.io/client-go/kubernetes"
+)
+
+// Route is a host and path of an ingress mapped to a port of a backend service.
+type Route struct {
+	// Host of the route, empty for all hosts.
+	Host string `json:"host"`
+
+	// Path of the route, empty for all paths.
+	Path string `json:"path"`
+
+	// Name of the ingress the route belongs to.
+	Ingress string `json:"ingress"`
+
+	// Whether TLS is terminated for the host.
+	TLS bool `json:"tls"`
+
+	// Name of the backend service.
+	ServiceName string `json:"serviceName"`
+
+	// Port number or name of the backend service.
+	ServicePort intstr.IntOrString `json:"servicePort" swaggertype:"string"`
+
+	// Number of ready and not ready addresses behind the service port.
+	ReadyEndpoints    int `json:"readyEndpoints"`
+	NotReadyEndpoints int `json:"notReadyEndpoints"`
+
+	// Whether the backend has at least one ready address.
+	Ready bool `json:"ready"`
+
+	// Why the backend can not be resolved, e.g. the service does not exist.
+	Error string `json:"error,omitempty"`
+}
+
+// RouteList contains the routes of all ingresses in a namespace.
+type RouteList struct {
+	api.ListMeta `json:"listMeta"`
+
+	// Routes sorted by host and path.
+	Items []Route `json:"items"`
+}
+
+// GetRouteList returns every host and path to service mapping of the ingresses in the namespace,
+// together with the readiness of the backends taken from the service endpoints.
+func GetRouteList(client client.Interface, namespace string) (*RouteList, error) {
+	ingresses, err := client.ExtensionsV1beta1().Ingresses(namespace).List(context.TODO(), api.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := client.CoreV1().Services(namespace).List(context.TODO(), api.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints, err := client.CoreV1().Endpoints(namespace).List(context.TODO(), api.ListEverything)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRouteList(ingresses.Items, services.Items, endpoints.Items), nil
+}
+
+func toRouteList(ingresses []extensions.Ingress, services []v1.Service, endpoints []v1.Endpoints) *RouteList {
+	serviceMap := make(map[string]v1.Service)
+	for _, service := range services {
+		serviceMap[service.Name] = service
+	}
+
+	endpointsMap := make(map[string]v1.Endpoints)
+	for _, e := range endpoints {
+		endpointsMap[e.Name] = e
+	}
+
+	routes := make([]Route, 0)
+	for _, ingress := range ingresses {
+		tlsHosts := make(map[string]bool)
+		for _, tls := range ingress.Spec.TLS {
+			for _, host := range tls.Hosts {
+				tlsHosts[host] = true
+			}
+		}
+
+		if backend := ingress.Spec.Backend; backend != nil {
+			routes = append(routes, newRoute(ingress.Name, "", "", false, *backend, serviceMap, endpointsMap))
+		}
+
+		for _, rule := range ingress.Spec.Rules {
+			if rule.HTTP == nil {
+				continue
+			}
+			for _, path := range rule.HTTP.Paths {
+				routes = append(routes, newRoute(ingress.Name, rule.Host, path.Path, tlsHosts[rule.Host],
+					path.Backend, serviceMap, endpointsMap))
+			}
+		}
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Host != routes[j].Host {
+			return routes[i].Host < routes[j].Host
+		}
+		return routes[i].Path < routes[j].Path
+	})
+
+	return &RouteList{
+		ListMeta: api.ListMeta{TotalItems: len(routes)},
+		Items:    routes,
+	}
+}
+
+func newRoute(ingress, host, path string, tls bool, backend extensions.IngressBackend,
+	services map[string]v1.Service, endpoints map[string]v1.Endpoints) Route {
+	route := Route{
+		Host:        host,
+		Path:        path,
+		Ingress:     ingress,
+		TLS:         tls,
+		ServiceName: backend.ServiceName,
+		ServicePort: backend.ServicePort,
+	}
+
+	service, ok := services[backend.ServiceName]
+	if !ok {
+		route.Error = fmt.Sprintf("service %s not found", backend.ServiceName)
+		return route
+	}
+
+	// External name services are resolved by DNS and have no endpoints.
+	if service.Spec.Type == v1.ServiceTypeExternalName {
+		route.Ready = true
+		return route
+	}
+
+	port, ok := findServicePort(service, backend.ServicePort)
+	if !ok {
+		route.Error = fmt.Sprintf("port %s not found in service %s", backend.ServicePort.String(), service.Name)
+		return route
+	}
+
+	// Endpoint ports carry the name of the service port they belong to.
+	for _, subset := range endpoints[service.Name].Subsets {
+		for _, p := range subset.Ports {
+			if p.Name == port.Name {
+				route.ReadyEndpoints += len(subset.Addresses)
+				route.NotReadyEndpoints += len(subset.NotReadyAddresses)
+				break
+			}
+		}
+	}
+
+	route.Ready = route.ReadyEndpoints > 0
+	return route
+}
+
+func findServicePort(service v1.Service, port intstr.IntOrString) (v1.ServicePort, bool) {
+	for _, p := range service.Spec.Ports {
+		if (port.Type == intstr.Int && p.Port == port.IntVal) || (port.Type == intstr.String && p.Name == port.StrVal) {
+			return p, true
+		}
+	}
+
+	return v1.ServicePort{}, false
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"reflect"
+	"testing"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/api"
+
+	v1 "k8s.io/api/core/v1"
+	extensions "k8s.io/api/extensions/v1beta1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestGetRouteList(t *testing.T) {
+	ingress := &extensions.Ingress{
+		ObjectMeta: metaV1.ObjectMeta{Name: "shop", Namespace: "ns-1"},
+		Spec: extensions.IngressSpec{
+			TLS: []extensions.IngressTLS{{Hosts: []string{"shop.example.com"}, SecretName: "shop-tls"}},
+			Rules: []extensions.IngressRule{
+				{
+					Host: "shop.example.com",
+					IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{
+							{Path: "/static", Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromString("static")}},
+							{Path: "/", Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(80)}},
+						},
+					}},
+				},
+				{
+					Host: "api.example.com",
+					IngressRuleValue: extensions.IngressRuleValue{HTTP: &extensions.HTTPIngressRuleValue{
+						Paths: []extensions.HTTPIngressPath{
+							{Path: "/", Backend: extensions.IngressBackend{ServiceName: "api", ServicePort: intstr.FromInt(80)}},
+							{Path: "/v2", Backend: extensions.IngressBackend{ServiceName: "web", ServicePort: intstr.FromInt(443)}},
+						},
+					}},
+				},
+			},
+		},
+	}
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns-1"},
+		Spec: v1.ServiceSpec{Ports: []v1.ServicePort{
+			{Name: "http", Port: 80},
+			{Name: "static", Port: 8080},
+		}},
+	}
+	endpoints := &v1.Endpoints{
+		ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns-1"},
+		Subsets: []v1.EndpointSubset{
+			{
+				Addresses:         []v1.EndpointAddress{{IP: "10.0.0.1"}, {IP: "10.0.0.2"}},
+				NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.3"}},
+				Ports:             []v1.EndpointPort{{Name: "http", Port: 8000}},
+			},
+			{
+				NotReadyAddresses: []v1.EndpointAddress{{IP: "10.0.0.4"}},
+				Ports:             []v1.EndpointPort{{Name: "static", Port: 8080}},
+			},
+		},
+	}
+	client := fake.NewSimpleClientset(ingress, service, endpoints)
+
+	expected := &RouteList{
+		ListMeta: api.ListMeta{TotalItems: 4},
+		Items: []Route{
+			{
+				Host: "api.example.com", Path: "/", Ingress: "shop", ServiceName: "api", ServicePort: intstr.FromInt(80),
+				Error: "service api not found",
+			},
+			{
+				Host: "api.example.com", Path: "/v2", Ingress: "shop", ServiceName: "web", ServicePort: intstr.FromInt(443),
+				Error: "port 443 not found in service web",
+			},
+			{
+				Host: "shop.example.com", Path: "/", Ingress: "shop", TLS: true, ServiceName: "web", ServicePort: intstr.FromInt(80),
+				ReadyEndpoints: 2, NotReadyEndpoints: 1, Ready: true,
+			},
+			{
+				Host: "shop.example.com", Path: "/static", Ingress: "shop", TLS: true, ServiceName: "web", ServicePort: intstr.FromString("static"),
+				NotReadyEndpoints: 1,
+			},
+		},
+	}
+
+	actual, err := GetRouteList(client, "ns-1")
+	if err != nil {
+		t.Fatalf("GetRouteList() returned error: %s", err)
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("GetRouteList() == \ngot %#v, \nexpected %#v", actual, expected)
+	}
+}
@@ -74,6 +74,32 @@ func GetNamespaceList(client kubernetes.Interface, dsQuery *dataselect.DataSelec
 	return toNamespaceList(namespaces.Items, nonCriticalErrors, dsQuery), nil
 }
 
+// GetNamespaceListByNames returns a list of the namespaces in the cluster whose names are in the
+// given list. Names of the namespaces that do not exist are ignored.
+func GetNamespaceListByNames(client kubernetes.Interface, names []string, dsQuery *dataselect.DataSelectQuery) (*NamespaceList, error) {
+	log.Println("Getting list of namespaces by names")
+	namespaces, err := client.CoreV1().Namespaces().List(context.TODO(), api.ListEverything)
+
+	nonCriticalErrors, criticalError := errors.HandleError(err)
+	if criticalError != nil {
+		return nil, criticalError
+	}
+
+	allowed := make(map[string]bool, len(names))
+	for _, name := range names {
+		allowed[name] = true
+	}
+
+	items := make([]v1.Namespace, 0, len(names))
+	for _, namespace := range namespaces.Items {
+		if allowed[namespace.Name] {
+			items = append(items, namespace)
+		}
+	}
+
+	return toNamespaceList(items, nonCriticalErrors, dsQuery), nil
+}
+
 func toNamespaceList(namespaces []v1.Namespace, nonCriticalErrors []error, dsQuery *dataselect.DataSelectQuery) *NamespaceList {
 	namespaceList := &NamespaceList{
 		Namespaces: make([]Namespace, 0),
@@ -23,6 +23,7 @@ import (
 
 	v1 "k8s.io/api/core/v1"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 func TestGetNamespaceList(t *testing.T) {
@@ -63,3 +64,19 @@ func TestGetNamespaceList(t *testing.T) {
 		}
 	}
 }
+
+func TestGetNamespaceListByNames(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "foo"}},
+		&v1.Namespace{ObjectMeta: metaV1.ObjectMeta{Name: "bar"}},
+	)
+
+	list, err := GetNamespaceListByNames(client, []string{"foo", "missing"}, dataselect.NoDataSelect)
+	if err != nil {
+		t.Fatalf("GetNamespaceListByNames() returned error: %s", err)
+	}
+
+	if list.ListMeta.TotalItems != 1 || list.Namespaces[0].ObjectMeta.Name != "foo" {
+		t.Errorf("GetNamespaceListByNames() == %#v, expected only namespace foo", list)
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// ResourceQuotaPreset is a named resource quota template that can be attached to new namespaces.
+type ResourceQuotaPreset struct {
+	// Hard is the set of desired hard limits for each named resource, e.g. "requests.cpu": "4".
+	Hard map[string]string `json:"hard" mapstructure:"hard"`
+
+	// Scopes is a collection of filters that must match each object tracked by the quota.
+	Scopes []string `json:"scopes" mapstructure:"scopes"`
+}
+
+// LimitRangePreset is a named limit range template that can be attached to new namespaces.
+type LimitRangePreset struct {
+	Limits []LimitRangeItemPreset `json:"limits" mapstructure:"limits"`
+}
+
+// LimitRangeItemPreset defines a min/max usage limit for any resource that matches on kind.
+type LimitRangeItemPreset struct {
+	// Type of resource that this limit applies to, e.g. Container or Pod.
+	Type                 string            `json:"type" mapstructure:"type"`
+	Max                  map[string]string `json:"max" mapstructure:"max"`
+	Min                  map[string]string `json:"min" mapstructure:"min"`
+	Default              map[string]string `json:"default" mapstructure:"default"`
+	DefaultRequest       map[string]string `json:"defaultRequest" mapstructure:"default_request"`
+	MaxLimitRequestRatio map[string]string `json:"maxLimitRequestRatio" mapstructure:"max_limit_request_ratio"`
+}
+
+// NewResourceQuota builds a resource quota of the namespace from the preset.
+func NewResourceQuota(name, namespace string, preset ResourceQuotaPreset) (*v1.ResourceQuota, error) {
+	hard, err := toResourceList(preset.Hard)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]v1.ResourceQuotaScope, 0, len(preset.Scopes))
+	for _, scope := range preset.Scopes {
+		scopes = append(scopes, v1.ResourceQuotaScope(scope))
+	}
+
+	return &v1.ResourceQuota{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1.ResourceQuotaSpec{
+			Hard:   hard,
+			Scopes: scopes,
+		},
+	}, nil
+}
+
+// NewLimitRange builds a limit range of the namespace from the preset.
+func NewLimitRange(name, namespace string, preset LimitRangePreset) (*v1.LimitRange, error) {
+	limits := make([]v1.LimitRangeItem, 0, len(preset.Limits))
+	for _, item := range preset.Limits {
+		limit := v1.LimitRangeItem{Type: v1.LimitType(item.Type)}
+
+		var err error
+		if limit.Max, err = toResourceList(item.Max); err != nil {
+			return nil, err
+		}
+		if limit.Min, err = toResourceList(item.Min); err != nil {
+			return nil, err
+		}
+		if limit.Default, err = toResourceList(item.Default); err != nil {
+			return nil, err
+		}
+		if limit.DefaultRequest, err = toResourceList(item.DefaultRequest); err != nil {
+			return nil, err
+		}
+		if limit.MaxLimitRequestRatio, err = toResourceList(item.MaxLimitRequestRatio); err != nil {
+			return nil, err
+		}
+
+		limits = append(limits, limit)
+	}
+
+	return &v1.LimitRange{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: v1.LimitRangeSpec{
+			Limits: limits,
+		},
+	}, nil
+}
+
+// CreateNamespaceWithPresets creates the namespace together with the given resource quota and
+// limit range, both of which are optional. The namespace is deleted if any of them can not be
+// created so that no namespace is left without its guardrails.
+func CreateNamespaceWithPresets(client kubernetes.Interface, spec *NamespaceSpec, quota *v1.ResourceQuota,
+	limitRange *v1.LimitRange) (*v1.Namespace, error) {
+
+	log.Printf("Creating namespace %s with presets", spec.Name)
+
+	namespace, err := client.CoreV1().Namespaces().Create(context.TODO(), &v1.Namespace{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name: spec.Name,
+		},
+	}, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if quota != nil {
+		_, err = client.CoreV1().ResourceQuotas(spec.Name).Create(context.TODO(), quota, metaV1.CreateOptions{})
+	}
+	if err == nil && limitRange != nil {
+		_, err = client.CoreV1().LimitRanges(spec.Name).Create(context.TODO(), limitRange, metaV1.CreateOptions{})
+	}
+	if err != nil {
+		if delErr := client.CoreV1().Namespaces().Delete(context.TODO(), spec.Name, metaV1.DeleteOptions{}); delErr != nil {
+			log.Printf("Couldn't delete namespace %s: %s", spec.Name, delErr)
+		}
+		return nil, err
+	}
+
+	return namespace, nil
+}
+
+func toResourceList(quantities map[string]string) (v1.ResourceList, error) {
+	if len(quantities) == 0 {
+		return nil, nil
+	}
+
+	list := make(v1.ResourceList, len(quantities))
+	for name, value := range quantities {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q of %s: %w", value, name, err)
+		}
+		list[v1.ResourceName(name)] = quantity
+	}
+
+	return list, nil
+}
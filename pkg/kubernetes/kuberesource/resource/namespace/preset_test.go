@@ -0,0 +1,109 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package namespace
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestNewResourceQuota(t *testing.T) {
+	preset := ResourceQuotaPreset{
+		Hard:   map[string]string{"requests.cpu": "2", "pods": "10"},
+		Scopes: []string{"NotTerminating"},
+	}
+
+	quota, err := NewResourceQuota("default", "foo", preset)
+	if err != nil {
+		t.Fatalf("NewResourceQuota() returned error: %s", err)
+	}
+
+	expected := &v1.ResourceQuota{
+		ObjectMeta: metaV1.ObjectMeta{Name: "default", Namespace: "foo"},
+		Spec: v1.ResourceQuotaSpec{
+			Hard: v1.ResourceList{
+				v1.ResourceRequestsCPU: resource.MustParse("2"),
+				v1.ResourcePods:        resource.MustParse("10"),
+			},
+			Scopes: []v1.ResourceQuotaScope{v1.ResourceQuotaScopeNotTerminating},
+		},
+	}
+	if !reflect.DeepEqual(quota, expected) {
+		t.Errorf("NewResourceQuota() == \n%#v\nexpected \n%#v\n", quota, expected)
+	}
+
+	preset.Hard["pods"] = "many"
+	if _, err := NewResourceQuota("default", "foo", preset); err == nil {
+		t.Error("NewResourceQuota() with invalid quantity should return error")
+	}
+}
+
+func TestNewLimitRange(t *testing.T) {
+	preset := LimitRangePreset{
+		Limits: []LimitRangeItemPreset{{
+			Type:           "Container",
+			Default:        map[string]string{"cpu": "500m"},
+			DefaultRequest: map[string]string{"memory": "128Mi"},
+		}},
+	}
+
+	limitRange, err := NewLimitRange("default", "foo", preset)
+	if err != nil {
+		t.Fatalf("NewLimitRange() returned error: %s", err)
+	}
+
+	expected := &v1.LimitRange{
+		ObjectMeta: metaV1.ObjectMeta{Name: "default", Namespace: "foo"},
+		Spec: v1.LimitRangeSpec{
+			Limits: []v1.LimitRangeItem{{
+				Type:           v1.LimitTypeContainer,
+				Default:        v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m")},
+				DefaultRequest: v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
+			}},
+		},
+	}
+	if !reflect.DeepEqual(limitRange, expected) {
+		t.Errorf("NewLimitRange() == \n%#v\nexpected \n%#v\n", limitRange, expected)
+	}
+}
+
+func TestCreateNamespaceWithPresets(t *testing.T) {
+	client := fake.NewSimpleClientset()
+	quota, _ := NewResourceQuota("default", "foo", ResourceQuotaPreset{Hard: map[string]string{"pods": "10"}})
+
+	if _, err := CreateNamespaceWithPresets(client, &NamespaceSpec{Name: "foo"}, quota, nil); err != nil {
+		t.Fatalf("CreateNamespaceWithPresets() returned error: %s", err)
+	}
+	if _, err := client.CoreV1().ResourceQuotas("foo").Get(context.TODO(), "default", metaV1.GetOptions{}); err != nil {
+		t.Errorf("expected resource quota to be created: %s", err)
+	}
+
+	// The limit range already exists, so the new namespace has to be removed again.
+	limitRange, _ := NewLimitRange("default", "bar", LimitRangePreset{})
+	client.CoreV1().LimitRanges("bar").Create(context.TODO(), limitRange, metaV1.CreateOptions{})
+
+	if _, err := CreateNamespaceWithPresets(client, &NamespaceSpec{Name: "bar"}, nil, limitRange); err == nil {
+		t.Fatal("CreateNamespaceWithPresets() should return error when the limit range can not be created")
+	}
+	if _, err := client.CoreV1().Namespaces().Get(context.TODO(), "bar", metaV1.GetOptions{}); err == nil {
+		t.Error("expected namespace to be deleted after the limit range failed")
+	}
+}
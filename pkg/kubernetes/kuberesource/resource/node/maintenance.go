@@ -0,0 +1,182 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	policy "k8s.io/api/policy/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// mirrorPodAnnotation is set on the pods that mirror static pods of the kubelet.
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// Interval between evictions that are rejected because of a PodDisruptionBudget.
+var evictionRetryInterval = 5 * time.Second
+
+// DrainOptions controls which pods can be evicted when draining a node.
+type DrainOptions struct {
+	// Force evicts pods that are not managed by a controller. Such pods are not recreated.
+	Force bool `json:"force"`
+
+	// DeleteLocalData evicts pods that use emptyDir volumes, the data of those volumes is lost.
+	DeleteLocalData bool `json:"deleteLocalData"`
+}
+
+// DrainResult lists what happened to the pods of a drained node.
+type DrainResult struct {
+	// Pods that were evicted.
+	Evicted []PodReference `json:"evicted"`
+
+	// Pods that were left on the node, e.g. DaemonSet and mirror pods.
+	Skipped []PodReference `json:"skipped"`
+}
+
+// PodReference identifies a pod of the drained node.
+type PodReference struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+
+	// Why the pod was skipped or could not be evicted.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SetUnschedulable cordons (unschedulable is true) or uncordons the node.
+func SetUnschedulable(client k8sClient.Interface, name string, unschedulable bool) (*v1.Node, error) {
+	log.Printf("Setting unschedulable of node %s to %t", name, unschedulable)
+
+	patch := fmt.Sprintf(`{"spec":{"unschedulable":%t}}`, unschedulable)
+	return client.CoreV1().Nodes().Patch(context.TODO(), name, types.MergePatchType, []byte(patch), metaV1.PatchOptions{})
+}
+
+// DrainNode cordons the node and evicts its pods. DaemonSet and mirror pods are skipped. Evictions
+// go through the eviction API so PodDisruptionBudgets are respected, evictions rejected by a budget
+// are retried until ctx is done. Nothing is evicted if any pod can not be evicted with the given
+// options. The result is returned together with the error, so the pods evicted before an eviction
+// failed are reported. DrainNode does not wait for the evicted pods to terminate.
+func DrainNode(ctx context.Context, client k8sClient.Interface, name string, opts DrainOptions) (*DrainResult, error) {
+	log.Printf("Draining node %s", name)
+
+	node, err := SetUnschedulable(client, name, true)
+	if err != nil {
+		return nil, err
+	}
+
+	pods, err := getNodePods(client, *node)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DrainResult{
+		Evicted: make([]PodReference, 0),
+		Skipped: make([]PodReference, 0),
+	}
+	toEvict := make([]v1.Pod, 0, len(pods.Items))
+	blocked := make([]string, 0)
+	for _, pod := range pods.Items {
+		ref := PodReference{Name: pod.Name, Namespace: pod.Namespace}
+
+		if skip, reason := skipPod(pod); skip {
+			ref.Reason = reason
+			result.Skipped = append(result.Skipped, ref)
+			continue
+		}
+
+		if reason := blockingReason(pod, opts); reason != "" {
+			blocked = append(blocked, fmt.Sprintf("%s/%s (%s)", pod.Namespace, pod.Name, reason))
+			continue
+		}
+
+		toEvict = append(toEvict, pod)
+	}
+
+	if len(blocked) > 0 {
+		return result, fmt.Errorf("cannot drain node %s, pods can not be evicted: %s", name, strings.Join(blocked, ", "))
+	}
+
+	for _, pod := range toEvict {
+		if err := evictPod(ctx, client, pod); err != nil {
+			return result, fmt.Errorf("evicting pod %s/%s: %w", pod.Namespace, pod.Name, err)
+		}
+		result.Evicted = append(result.Evicted, PodReference{Name: pod.Name, Namespace: pod.Namespace})
+	}
+
+	return result, nil
+}
+
+// Returns true if the pod stays on the drained node.
+func skipPod(pod v1.Pod) (bool, string) {
+	if _, ok := pod.Annotations[mirrorPodAnnotation]; ok {
+		return true, "mirror pod"
+	}
+
+	if ref := metaV1.GetControllerOf(&pod); ref != nil && ref.Kind == "DaemonSet" {
+		return true, "managed by DaemonSet " + ref.Name
+	}
+
+	return false, ""
+}
+
+// Returns why the pod can not be evicted with the given options, empty if it can be evicted.
+func blockingReason(pod v1.Pod, opts DrainOptions) string {
+	if metaV1.GetControllerOf(&pod) == nil && !opts.Force {
+		return "not managed by a controller"
+	}
+
+	if !opts.DeleteLocalData {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.EmptyDir != nil {
+				return "uses local data"
+			}
+		}
+	}
+
+	return ""
+}
+
+// Evicts the pod, retrying while a PodDisruptionBudget does not allow the eviction.
+func evictPod(ctx context.Context, client k8sClient.Interface, pod v1.Pod) error {
+	eviction := &policy.Eviction{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+		},
+	}
+
+	for {
+		err := client.PolicyV1beta1().Evictions(pod.Namespace).Evict(ctx, eviction)
+		if err == nil || k8serrors.IsNotFound(err) {
+			return nil
+		}
+		if !k8serrors.IsTooManyRequests(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%s: %w", err, ctx.Err())
+		case <-time.After(evictionRetryInterval):
+		}
+	}
+}
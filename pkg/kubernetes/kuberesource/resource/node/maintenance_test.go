@@ -0,0 +1,160 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package node
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+)
+
+func newNodePod(name string, owner string, volumes ...v1.Volume) *v1.Pod {
+	pod := &v1.Pod{
+		ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       v1.PodSpec{NodeName: "node-1", Volumes: volumes},
+	}
+	if owner != "" {
+		controller := true
+		pod.OwnerReferences = []metaV1.OwnerReference{{Kind: owner, Name: owner + "-1", Controller: &controller}}
+	}
+
+	return pod
+}
+
+func TestSetUnschedulable(t *testing.T) {
+	client := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-1"}})
+
+	node, err := SetUnschedulable(client, "node-1", true)
+	if err != nil {
+		t.Fatalf("SetUnschedulable() returned error: %s", err)
+	}
+	if !node.Spec.Unschedulable {
+		t.Error("expected node to be unschedulable")
+	}
+
+	node, _ = SetUnschedulable(client, "node-1", false)
+	if node.Spec.Unschedulable {
+		t.Error("expected node to be schedulable")
+	}
+}
+
+func TestDrainNode(t *testing.T) {
+	evictionRetryInterval = time.Millisecond
+
+	client := fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-1"}},
+		newNodePod("web", "ReplicaSet"),
+		newNodePod("agent", "DaemonSet"),
+	)
+
+	// The first eviction is rejected by a PodDisruptionBudget.
+	rejected := false
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "eviction" || rejected {
+			return false, nil, nil
+		}
+		rejected = true
+		return true, nil, k8serrors.NewTooManyRequests("disruption budget", 0)
+	})
+
+	result, err := DrainNode(context.TODO(), client, "node-1", DrainOptions{})
+	if err != nil {
+		t.Fatalf("DrainNode() returned error: %s", err)
+	}
+
+	if len(result.Evicted) != 1 || result.Evicted[0].Name != "web" {
+		t.Errorf("expected pod web to be evicted, got %#v", result.Evicted)
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Name != "agent" {
+		t.Errorf("expected pod agent to be skipped, got %#v", result.Skipped)
+	}
+	if !rejected {
+		t.Error("expected the rejected eviction to be retried")
+	}
+
+	node, _ := client.CoreV1().Nodes().Get(context.TODO(), "node-1", metaV1.GetOptions{})
+	if !node.Spec.Unschedulable {
+		t.Error("expected drained node to be cordoned")
+	}
+}
+
+func TestDrainNodePartial(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-1"}},
+		newNodePod("web", "ReplicaSet"),
+		newNodePod("broken", "ReplicaSet"),
+		newNodePod("agent", "DaemonSet"),
+	)
+
+	client.PrependReactor("create", "pods", func(action core.Action) (bool, runtime.Object, error) {
+		create, ok := action.(core.CreateAction)
+		if !ok || action.GetSubresource() != "eviction" {
+			return false, nil, nil
+		}
+		if create.GetObject().(metaV1.Object).GetName() == "broken" {
+			return true, nil, k8serrors.NewInternalError(fmt.Errorf("eviction failed"))
+		}
+		return false, nil, nil
+	})
+
+	result, err := DrainNode(context.TODO(), client, "node-1", DrainOptions{})
+	if err == nil {
+		t.Fatal("expected DrainNode() to return the eviction error")
+	}
+	if result == nil {
+		t.Fatal("expected DrainNode() to return the partial result")
+	}
+
+	for _, ref := range result.Evicted {
+		if ref.Name == "broken" {
+			t.Errorf("expected pod broken not to be evicted, got %#v", result.Evicted)
+		}
+	}
+	if len(result.Skipped) != 1 || result.Skipped[0].Name != "agent" {
+		t.Errorf("expected pod agent to be skipped, got %#v", result.Skipped)
+	}
+}
+
+func TestDrainNodeBlocked(t *testing.T) {
+	emptyDir := v1.Volume{Name: "cache", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}}
+	cases := []struct {
+		pod       *v1.Pod
+		opts      DrainOptions
+		expectErr bool
+	}{
+		{newNodePod("bare", ""), DrainOptions{}, true},
+		{newNodePod("bare", ""), DrainOptions{Force: true}, false},
+		{newNodePod("cache", "ReplicaSet", emptyDir), DrainOptions{}, true},
+		{newNodePod("cache", "ReplicaSet", emptyDir), DrainOptions{DeleteLocalData: true}, false},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset(&v1.Node{ObjectMeta: metaV1.ObjectMeta{Name: "node-1"}}, c.pod)
+
+		_, err := DrainNode(context.TODO(), client, "node-1", c.opts)
+		if (err != nil) != c.expectErr {
+			t.Errorf("DrainNode() of pod %s with %#v returned error %v, expected error: %t",
+				c.pod.Name, c.opts, err, c.expectErr)
+		}
+	}
+}
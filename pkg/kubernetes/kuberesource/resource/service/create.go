@@ -0,0 +1,199 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/api"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	k8sClient "k8s.io/client-go/kubernetes"
+)
+
+// Spec is the part of a service that is set when the service is created or updated.
+type Spec struct {
+	// Type of the service, one of ClusterIP, NodePort and LoadBalancer. Defaults to ClusterIP.
+	Type v1.ServiceType `json:"type"`
+
+	// Whether the service is headless, i.e. has no cluster IP. Only valid for ClusterIP services.
+	Headless bool `json:"headless"`
+
+	// Labels of the pods the traffic is routed to.
+	Selector map[string]string `json:"selector"`
+
+	// Ports of the service, a node port of zero is allocated by the apiserver.
+	Ports []v1.ServicePort `json:"ports"`
+}
+
+// ValidateSpec checks that the spec is consistent, that the node ports are not used by any other
+// service of the cluster and that the selector matches at least one pod in the namespace. The name
+// is the name of the service, its own node ports are not reported as used. The node port range is
+// configured on the apiserver, which rejects node ports out of it.
+func ValidateSpec(client k8sClient.Interface, namespace, name string, spec Spec) error {
+	serviceType := spec.Type
+	if serviceType == "" {
+		serviceType = v1.ServiceTypeClusterIP
+	}
+
+	switch serviceType {
+	case v1.ServiceTypeClusterIP, v1.ServiceTypeNodePort, v1.ServiceTypeLoadBalancer:
+	default:
+		return fmt.Errorf("unsupported service type %s", serviceType)
+	}
+
+	if spec.Headless && serviceType != v1.ServiceTypeClusterIP {
+		return fmt.Errorf("a headless service must be of type %s", v1.ServiceTypeClusterIP)
+	}
+
+	if len(spec.Ports) == 0 && !spec.Headless {
+		return errors.New("at least one port is required")
+	}
+
+	if len(spec.Selector) == 0 {
+		return errors.New("selector is required")
+	}
+
+	nodePorts := make(map[int32]bool)
+	for _, port := range spec.Ports {
+		if port.NodePort == 0 {
+			continue
+		}
+		if serviceType == v1.ServiceTypeClusterIP {
+			return fmt.Errorf("node port %d is not allowed for a %s service", port.NodePort, serviceType)
+		}
+		if nodePorts[port.NodePort] {
+			return fmt.Errorf("node port %d is used more than once", port.NodePort)
+		}
+		nodePorts[port.NodePort] = true
+	}
+
+	if len(nodePorts) > 0 {
+		if err := checkNodePortsUnused(client, namespace, name, nodePorts); err != nil {
+			return err
+		}
+	}
+
+	pods, err := client.CoreV1().Pods(namespace).List(context.TODO(), metaV1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(spec.Selector).String(),
+	})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("selector %s does not match any pod in namespace %s", labels.Set(spec.Selector), namespace)
+	}
+
+	return nil
+}
+
+// Node ports are allocated cluster wide, so the services of all namespaces are checked. The
+// owner of a used node port is not reported because it may be in a namespace of another user.
+func checkNodePortsUnused(client k8sClient.Interface, namespace, name string, nodePorts map[int32]bool) error {
+	services, err := client.CoreV1().Services(v1.NamespaceAll).List(context.TODO(), api.ListEverything)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services.Items {
+		if service.Namespace == namespace && service.Name == name {
+			continue
+		}
+		for _, port := range service.Spec.Ports {
+			if nodePorts[port.NodePort] {
+				return fmt.Errorf("node port %d is already allocated", port.NodePort)
+			}
+		}
+	}
+
+	return nil
+}
+
+// CreateService creates a service with the given spec.
+func CreateService(client k8sClient.Interface, namespace, name string, spec Spec) (*v1.Service, error) {
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    spec.Selector,
+		},
+		Spec: v1.ServiceSpec{
+			Type:     spec.Type,
+			Selector: spec.Selector,
+			Ports:    spec.Ports,
+		},
+	}
+
+	if spec.Headless {
+		service.Spec.ClusterIP = v1.ClusterIPNone
+	}
+
+	return client.CoreV1().Services(namespace).Create(context.TODO(), service, metaV1.CreateOptions{})
+}
+
+// UpdateService replaces the type, selector and ports of the service. The cluster IP can not be
+// changed, so a service can not be switched between headless and not headless. Ports without a
+// node port keep the node port allocated to the same port and protocol before.
+func UpdateService(client k8sClient.Interface, namespace, name string, spec Spec) (*v1.Service, error) {
+	service, err := client.CoreV1().Services(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.Headless != (service.Spec.ClusterIP == v1.ClusterIPNone) {
+		return nil, fmt.Errorf("service %s can not be switched between headless and not headless", name)
+	}
+
+	serviceType := spec.Type
+	if serviceType == "" {
+		serviceType = v1.ServiceTypeClusterIP
+	}
+
+	ports := make([]v1.ServicePort, len(spec.Ports))
+	copy(ports, spec.Ports)
+	for i := range ports {
+		if serviceType == v1.ServiceTypeClusterIP {
+			ports[i].NodePort = 0
+			continue
+		}
+		if ports[i].NodePort != 0 {
+			continue
+		}
+		for _, old := range service.Spec.Ports {
+			if old.Port == ports[i].Port && old.Protocol == ports[i].Protocol {
+				ports[i].NodePort = old.NodePort
+				break
+			}
+		}
+	}
+
+	service.Spec.Type = serviceType
+	service.Spec.Selector = spec.Selector
+	service.Spec.Ports = ports
+	// Fields only valid for some service types are cleared, the apiserver rejects the update otherwise.
+	if serviceType == v1.ServiceTypeClusterIP {
+		service.Spec.ExternalTrafficPolicy = ""
+	}
+	if serviceType != v1.ServiceTypeLoadBalancer {
+		service.Spec.HealthCheckNodePort = 0
+		service.Spec.LoadBalancerSourceRanges = nil
+	}
+
+	return client.CoreV1().Services(namespace).Update(context.TODO(), service, metaV1.UpdateOptions{})
+}
@@ -0,0 +1,125 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newSpecTestClient() *fake.Clientset {
+	return fake.NewSimpleClientset(
+		&v1.Pod{ObjectMeta: metaV1.ObjectMeta{Name: "web-1", Namespace: "ns-1", Labels: map[string]string{"app": "web"}}},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Name: "other", Namespace: "ns-2"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeNodePort, Ports: []v1.ServicePort{{Port: 80, NodePort: 30080}}},
+		},
+		&v1.Service{
+			ObjectMeta: metaV1.ObjectMeta{Name: "web", Namespace: "ns-1"},
+			Spec:       v1.ServiceSpec{Type: v1.ServiceTypeNodePort, Ports: []v1.ServicePort{{Port: 80, NodePort: 30081}}},
+		},
+	)
+}
+
+func TestValidateSpec(t *testing.T) {
+	selector := map[string]string{"app": "web"}
+	cases := []struct {
+		name  string
+		spec  Spec
+		valid bool
+	}{
+		{"web", Spec{Selector: selector, Ports: []v1.ServicePort{{Port: 80}}}, true},
+		{"web", Spec{Selector: selector, Headless: true}, true},
+		{"web", Spec{Type: v1.ServiceTypeNodePort, Selector: selector, Ports: []v1.ServicePort{{Port: 80, NodePort: 30081}}}, true},
+		{"web", Spec{Type: v1.ServiceTypeLoadBalancer, Selector: selector, Ports: []v1.ServicePort{{Port: 80}}}, true},
+		{"web", Spec{Type: v1.ServiceTypeExternalName, Selector: selector, Ports: []v1.ServicePort{{Port: 80}}}, false},
+		{"web", Spec{Type: v1.ServiceTypeNodePort, Selector: selector, Headless: true}, false},
+		{"web", Spec{Selector: selector}, false},
+		{"web", Spec{Ports: []v1.ServicePort{{Port: 80}}}, false},
+		{"web", Spec{Selector: map[string]string{"app": "db"}, Ports: []v1.ServicePort{{Port: 80}}}, false},
+		{"web", Spec{Selector: selector, Ports: []v1.ServicePort{{Port: 80, NodePort: 30090}}}, false},
+		{"web", Spec{Type: v1.ServiceTypeNodePort, Selector: selector, Ports: []v1.ServicePort{{Port: 80, NodePort: 30080}}}, false},
+		{"new", Spec{Type: v1.ServiceTypeNodePort, Selector: selector, Ports: []v1.ServicePort{{Port: 80, NodePort: 30081}}}, false},
+		{"web", Spec{Type: v1.ServiceTypeNodePort, Selector: selector, Ports: []v1.ServicePort{
+			{Port: 80, NodePort: 30090}, {Port: 443, NodePort: 30090},
+		}}, false},
+	}
+
+	for _, c := range cases {
+		err := ValidateSpec(newSpecTestClient(), "ns-1", c.name, c.spec)
+		if (err == nil) != c.valid {
+			t.Errorf("ValidateSpec(%s, %#v) returned %v, expected valid %v", c.name, c.spec, err, c.valid)
+		}
+	}
+}
+
+func TestValidateSpecUsedNodePort(t *testing.T) {
+	spec := Spec{Type: v1.ServiceTypeNodePort, Selector: map[string]string{"app": "web"}, Ports: []v1.ServicePort{{Port: 80, NodePort: 30080}}}
+
+	// The service using the node port is in another namespace and must not be reported.
+	err := ValidateSpec(newSpecTestClient(), "ns-1", "web", spec)
+	if err == nil || err.Error() != "node port 30080 is already allocated" {
+		t.Errorf("ValidateSpec() returned %v, expected node port 30080 to be already allocated", err)
+	}
+}
+
+func TestUpdateService(t *testing.T) {
+	client := newSpecTestClient()
+	spec := Spec{
+		Type:     v1.ServiceTypeNodePort,
+		Selector: map[string]string{"app": "web"},
+		Ports: []v1.ServicePort{
+			{Port: 80, TargetPort: intstr.FromInt(8080)},
+			{Port: 443, NodePort: 30443},
+		},
+	}
+
+	updated, err := UpdateService(client, "ns-1", "web", spec)
+	if err != nil {
+		t.Fatalf("UpdateService() returned error: %s", err)
+	}
+
+	expected := []v1.ServicePort{
+		{Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 30081},
+		{Port: 443, NodePort: 30443},
+	}
+	if !reflect.DeepEqual(updated.Spec.Ports, expected) {
+		t.Errorf("UpdateService() set ports %#v, expected %#v", updated.Spec.Ports, expected)
+	}
+	if spec.Ports[0].NodePort != 0 {
+		t.Errorf("UpdateService() modified the ports of the spec")
+	}
+
+	spec.Type = v1.ServiceTypeClusterIP
+	updated, err = UpdateService(client, "ns-1", "web", spec)
+	if err != nil {
+		t.Fatalf("UpdateService() returned error: %s", err)
+	}
+	for _, port := range updated.Spec.Ports {
+		if port.NodePort != 0 {
+			t.Errorf("UpdateService() kept node port %d of a ClusterIP service", port.NodePort)
+		}
+	}
+
+	spec.Headless = true
+	if _, err := UpdateService(client, "ns-1", "web", spec); err == nil {
+		t.Errorf("UpdateService() expected an error when switching to headless")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// GoverningServiceLabel marks the governing service created together with a stateful set, the
+// value is the name of the stateful set. Only services with this label are deleted together with
+// the stateful set.
+const GoverningServiceLabel = "hello-k8s/governing-service-of"
+
+// CreateStatefulSet creates the stateful set and its governing service named by Spec.ServiceName.
+// An existing service with that name is reused and left untouched. Otherwise the service is
+// labeled with GoverningServiceLabel and created before the stateful set, so that the pods get
+// their DNS names as soon as they are running, and it is deleted again if the stateful set can
+// not be created. The governing service is returned together with the stateful set.
+func CreateStatefulSet(client kubernetes.Interface, namespace string, statefulSet *apps.StatefulSet,
+	service *v1.Service) (*apps.StatefulSet, *v1.Service, error) {
+	name := statefulSet.Spec.ServiceName
+	existing, err := client.CoreV1().Services(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err == nil {
+		created, err := client.AppsV1().StatefulSets(namespace).Create(context.TODO(), statefulSet, metaV1.CreateOptions{})
+		return created, existing, err
+	}
+	if !k8serrors.IsNotFound(err) {
+		return nil, nil, err
+	}
+
+	service = service.DeepCopy()
+	service.Name = name
+	if service.Labels == nil {
+		service.Labels = make(map[string]string)
+	}
+	service.Labels[GoverningServiceLabel] = statefulSet.Name
+
+	service, err = client.CoreV1().Services(namespace).Create(context.TODO(), service, metaV1.CreateOptions{})
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating governing service %s: %w", name, err)
+	}
+
+	created, err := client.AppsV1().StatefulSets(namespace).Create(context.TODO(), statefulSet, metaV1.CreateOptions{})
+	if err != nil {
+		// Roll back the service so that the request can be retried.
+		if err := client.CoreV1().Services(namespace).Delete(context.TODO(), name, metaV1.DeleteOptions{}); err != nil && !k8serrors.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("rolling back governing service %s: %w", name, err)
+		}
+		return nil, nil, err
+	}
+
+	return created, service, nil
+}
+
+// Deletes the governing service of the stateful set if it was created by CreateStatefulSet.
+func deleteGoverningService(client kubernetes.Interface, namespace string, statefulSet *apps.StatefulSet) error {
+	name := statefulSet.Spec.ServiceName
+	if name == "" {
+		return nil
+	}
+
+	service, err := client.CoreV1().Services(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if service.Labels[GoverningServiceLabel] != statefulSet.Name {
+		return nil
+	}
+
+	err = client.CoreV1().Services(namespace).Delete(context.TODO(), name, metaV1.DeleteOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	}
+
+	return err
+}
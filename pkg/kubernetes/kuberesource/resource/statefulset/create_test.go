@@ -0,0 +1,95 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestGoverningStatefulSet() (*apps.StatefulSet, *v1.Service) {
+	statefulSet := newTestStatefulSet()
+	statefulSet.Spec.ServiceName = "db"
+	service := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "ns"},
+		Spec:       v1.ServiceSpec{ClusterIP: v1.ClusterIPNone},
+	}
+
+	return statefulSet, service
+}
+
+func TestCreateDeleteStatefulSet(t *testing.T) {
+	client := fake.NewSimpleClientset()
+
+	// The stateful set can be created again after it has been deleted.
+	for i := 0; i < 2; i++ {
+		statefulSet, service := newTestGoverningStatefulSet()
+		_, created, err := CreateStatefulSet(client, "ns", statefulSet, service)
+		if err != nil {
+			t.Fatalf("CreateStatefulSet() #%d returned error: %s", i, err)
+		}
+		if created.Labels[GoverningServiceLabel] != "db" {
+			t.Errorf("expected governing service to be labeled, got %v", created.Labels)
+		}
+
+		if _, err := DeleteStatefulSet(client, "ns", "db", false); err != nil {
+			t.Fatalf("DeleteStatefulSet() #%d returned error: %s", i, err)
+		}
+		if _, err := client.CoreV1().Services("ns").Get(context.TODO(), "db", metaV1.GetOptions{}); err == nil {
+			t.Errorf("expected governing service to be deleted with the stateful set")
+		}
+	}
+}
+
+func TestCreateStatefulSetExistingService(t *testing.T) {
+	existing := &v1.Service{
+		ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "ns", Labels: map[string]string{"app": "db"}},
+		Spec:       v1.ServiceSpec{ClusterIP: v1.ClusterIPNone},
+	}
+	client := fake.NewSimpleClientset(existing)
+
+	statefulSet, service := newTestGoverningStatefulSet()
+	_, reused, err := CreateStatefulSet(client, "ns", statefulSet, service)
+	if err != nil {
+		t.Fatalf("CreateStatefulSet() returned error: %s", err)
+	}
+	if _, ok := reused.Labels[GoverningServiceLabel]; ok {
+		t.Errorf("expected existing service not to be labeled, got %v", reused.Labels)
+	}
+
+	if _, err := DeleteStatefulSet(client, "ns", "db", false); err != nil {
+		t.Fatalf("DeleteStatefulSet() returned error: %s", err)
+	}
+	if _, err := client.CoreV1().Services("ns").Get(context.TODO(), "db", metaV1.GetOptions{}); err != nil {
+		t.Errorf("expected existing service to be kept, got %s", err)
+	}
+}
+
+func TestCreateStatefulSetRollback(t *testing.T) {
+	statefulSet, service := newTestGoverningStatefulSet()
+	client := fake.NewSimpleClientset(statefulSet.DeepCopy())
+
+	if _, _, err := CreateStatefulSet(client, "ns", statefulSet, service); err == nil {
+		t.Fatal("expected CreateStatefulSet() of an existing stateful set to fail")
+	}
+	if _, err := client.CoreV1().Services("ns").Get(context.TODO(), "db", metaV1.GetOptions{}); err == nil {
+		t.Errorf("expected created service to be rolled back")
+	}
+}
@@ -32,6 +32,27 @@ type StatefulSetDetail struct {
 	// Extends list item structure.
 	StatefulSet `json:",inline"`
 
+	// Name of the governing service of the stateful set.
+	ServiceName string `json:"serviceName"`
+
+	// Pod management policy, OrderedReady or Parallel.
+	PodManagementPolicy apps.PodManagementPolicyType `json:"podManagementPolicy"`
+
+	// Update strategy, the partition of rolling updates is part of it.
+	UpdateStrategy apps.StatefulSetUpdateStrategy `json:"updateStrategy"`
+
+	// Names of the volume claim templates.
+	VolumeClaimTemplates []string `json:"volumeClaimTemplates"`
+
+	// Revision of the pods with an ordinal lower than the partition.
+	CurrentRevision string `json:"currentRevision"`
+
+	// Revision of the pods with an ordinal greater than or equal to the partition.
+	UpdateRevision string `json:"updateRevision"`
+
+	// Number of pods at the update revision.
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+
 	// List of non-critical errors, that occurred during resource retrieval.
 	Errors []error `json:"errors"`
 }
@@ -57,8 +78,20 @@ func GetStatefulSetDetail(client kubernetes.Interface, metricClient metricapi.Me
 }
 
 func getStatefulSetDetail(statefulSet *apps.StatefulSet, podInfo *common.PodInfo, nonCriticalErrors []error) StatefulSetDetail {
+	templates := make([]string, 0, len(statefulSet.Spec.VolumeClaimTemplates))
+	for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+		templates = append(templates, template.Name)
+	}
+
 	return StatefulSetDetail{
-		StatefulSet: toStatefulSet(statefulSet, podInfo),
-		Errors:      nonCriticalErrors,
+		StatefulSet:          toStatefulSet(statefulSet, podInfo),
+		ServiceName:          statefulSet.Spec.ServiceName,
+		PodManagementPolicy:  statefulSet.Spec.PodManagementPolicy,
+		UpdateStrategy:       statefulSet.Spec.UpdateStrategy,
+		VolumeClaimTemplates: templates,
+		CurrentRevision:      statefulSet.Status.CurrentRevision,
+		UpdateRevision:       statefulSet.Status.UpdateRevision,
+		UpdatedReplicas:      statefulSet.Status.UpdatedReplicas,
+		Errors:               nonCriticalErrors,
 	}
 }
@@ -0,0 +1,51 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"log"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/common"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/event"
+
+	"k8s.io/client-go/kubernetes"
+)
+
+// GetStatefulSetEvents returns the events of the stateful set together with the events of its pods,
+// e.g. failures to bind the persistent volume claims.
+func GetStatefulSetEvents(client kubernetes.Interface, dsQuery *dataselect.DataSelectQuery, namespace, name string) (
+	*common.EventList, error) {
+	log.Printf("Getting events related to %s statefulset in %s namespace", name, namespace)
+
+	events, err := event.GetEvents(client, namespace, name)
+	if err != nil {
+		return event.EmptyEventList, err
+	}
+
+	pods, err := getRawStatefulSetPods(client, name, namespace)
+	if err != nil {
+		return event.EmptyEventList, err
+	}
+
+	podEvents, err := event.GetPodsEvents(client, namespace, pods)
+	if err != nil {
+		return event.EmptyEventList, err
+	}
+
+	events = append(events, event.FillEventsType(podEvents)...)
+	eventList := event.CreateEventList(events, dsQuery)
+	return &eventList, nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// UpdateOptions are the changes of a partitioned rolling update.
+type UpdateOptions struct {
+	// Container whose image is replaced, the image is not changed if it is empty.
+	Container string `json:"container"`
+
+	// New image of the container.
+	Image string `json:"image"`
+
+	// Pods with an ordinal greater than or equal to the partition are updated, the others keep
+	// the current revision. Nil keeps the partition of the stateful set.
+	Partition *int32 `json:"partition"`
+}
+
+// UpdateStatefulSet switches the stateful set to the RollingUpdate strategy with the given
+// partition and replaces the container image, which starts a rolling update of the pods with
+// an ordinal greater than or equal to the partition.
+func UpdateStatefulSet(client kubernetes.Interface, namespace, name string, opts UpdateOptions) (*apps.StatefulSet, error) {
+	statefulSet, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Container != "" &&
+		!setContainerImage(statefulSet.Spec.Template.Spec.Containers, opts.Container, opts.Image) &&
+		!setContainerImage(statefulSet.Spec.Template.Spec.InitContainers, opts.Container, opts.Image) {
+		return nil, fmt.Errorf("container %s not found in stateful set %s", opts.Container, name)
+	}
+
+	strategy := &statefulSet.Spec.UpdateStrategy
+	strategy.Type = apps.RollingUpdateStatefulSetStrategyType
+	if opts.Partition != nil {
+		strategy.RollingUpdate = &apps.RollingUpdateStatefulSetStrategy{Partition: opts.Partition}
+	}
+
+	return client.AppsV1().StatefulSets(namespace).Update(context.TODO(), statefulSet, metaV1.UpdateOptions{})
+}
+
+func setContainerImage(containers []v1.Container, name, image string) bool {
+	for i := range containers {
+		if containers[i].Name == name {
+			containers[i].Image = image
+			return true
+		}
+	}
+
+	return false
+}
+
+// DeleteStatefulSet deletes the stateful set and its pods, and the governing service if it was
+// created by CreateStatefulSet. With deleteClaims the persistent volume claims created from its
+// volume claim templates are deleted too, the names of the deleted claims are returned. The claims
+// are removed by the apiserver once the pods using them are gone.
+func DeleteStatefulSet(client kubernetes.Interface, namespace, name string, deleteClaims bool) ([]string, error) {
+	statefulSet, err := client.AppsV1().StatefulSets(namespace).Get(context.TODO(), name, metaV1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	deletePropagation := metaV1.DeletePropagationBackground
+	options := metaV1.DeleteOptions{
+		PropagationPolicy: &deletePropagation,
+	}
+	if err := client.AppsV1().StatefulSets(namespace).Delete(context.TODO(), name, options); err != nil {
+		return nil, err
+	}
+
+	if err := deleteGoverningService(client, namespace, statefulSet); err != nil {
+		return nil, err
+	}
+
+	deleted := make([]string, 0)
+	if !deleteClaims || len(statefulSet.Spec.VolumeClaimTemplates) == 0 {
+		return deleted, nil
+	}
+
+	claims, err := client.CoreV1().PersistentVolumeClaims(namespace).List(context.TODO(), metaV1.ListOptions{})
+	if err != nil {
+		return deleted, err
+	}
+
+	for _, claim := range claims.Items {
+		if !isStatefulSetClaim(statefulSet, claim.Name) {
+			continue
+		}
+
+		err := client.CoreV1().PersistentVolumeClaims(namespace).Delete(context.TODO(), claim.Name, metaV1.DeleteOptions{})
+		if err != nil {
+			return deleted, err
+		}
+		deleted = append(deleted, claim.Name)
+	}
+
+	return deleted, nil
+}
+
+// Returns true if the claim is created from a volume claim template of the stateful set. Such
+// claims are named <template>-<stateful set>-<ordinal>.
+func isStatefulSetClaim(statefulSet *apps.StatefulSet, claim string) bool {
+	for _, template := range statefulSet.Spec.VolumeClaimTemplates {
+		prefix := template.Name + "-" + statefulSet.Name + "-"
+		if !strings.HasPrefix(claim, prefix) {
+			continue
+		}
+
+		suffix := strings.TrimPrefix(claim, prefix)
+		if ordinal, err := strconv.Atoi(suffix); err == nil && ordinal >= 0 && strconv.Itoa(ordinal) == suffix {
+			return true
+		}
+	}
+
+	return false
+}
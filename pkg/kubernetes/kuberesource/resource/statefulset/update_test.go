@@ -0,0 +1,124 @@
+// Copyright 2017 The Kubernetes Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func newTestStatefulSet() *apps.StatefulSet {
+	return &apps.StatefulSet{
+		ObjectMeta: metaV1.ObjectMeta{Name: "db", Namespace: "ns"},
+		Spec: apps.StatefulSetSpec{
+			Replicas: getReplicasPointer(3),
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{Containers: []v1.Container{{Name: "postgres", Image: "postgres:11"}}},
+			},
+			VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{ObjectMeta: metaV1.ObjectMeta{Name: "data"}},
+			},
+		},
+	}
+}
+
+func newTestClaim(name string) *v1.PersistentVolumeClaim {
+	return &v1.PersistentVolumeClaim{ObjectMeta: metaV1.ObjectMeta{Name: name, Namespace: "ns"}}
+}
+
+func TestUpdateStatefulSet(t *testing.T) {
+	client := fake.NewSimpleClientset(newTestStatefulSet())
+
+	partition := int32(2)
+	updated, err := UpdateStatefulSet(client, "ns", "db", UpdateOptions{
+		Container: "postgres",
+		Image:     "postgres:12",
+		Partition: &partition,
+	})
+	if err != nil {
+		t.Fatalf("UpdateStatefulSet() returned error: %s", err)
+	}
+
+	if image := updated.Spec.Template.Spec.Containers[0].Image; image != "postgres:12" {
+		t.Errorf("expected image postgres:12, got %s", image)
+	}
+	strategy := updated.Spec.UpdateStrategy
+	if strategy.Type != apps.RollingUpdateStatefulSetStrategyType || strategy.RollingUpdate == nil ||
+		*strategy.RollingUpdate.Partition != partition {
+		t.Errorf("unexpected update strategy %#v", strategy)
+	}
+
+	// The partition is kept when only the image changes.
+	updated, err = UpdateStatefulSet(client, "ns", "db", UpdateOptions{Container: "postgres", Image: "postgres:13"})
+	if err != nil {
+		t.Fatalf("UpdateStatefulSet() returned error: %s", err)
+	}
+	if *updated.Spec.UpdateStrategy.RollingUpdate.Partition != partition {
+		t.Errorf("expected partition %d to be kept, got %d", partition, *updated.Spec.UpdateStrategy.RollingUpdate.Partition)
+	}
+
+	if _, err := UpdateStatefulSet(client, "ns", "db", UpdateOptions{Container: "missing", Image: "x"}); err == nil {
+		t.Errorf("UpdateStatefulSet() with an unknown container should return error")
+	}
+}
+
+func TestDeleteStatefulSet(t *testing.T) {
+	cases := []struct {
+		deleteClaims bool
+		deleted      []string
+		remaining    []string
+	}{
+		{false, []string{}, []string{"data-db-0", "data-db-1", "data-db-backup-0", "data-dbx-0", "logs"}},
+		{true, []string{"data-db-0", "data-db-1"}, []string{"data-db-backup-0", "data-dbx-0", "logs"}},
+	}
+
+	for _, c := range cases {
+		client := fake.NewSimpleClientset(newTestStatefulSet(),
+			newTestClaim("data-db-0"), newTestClaim("data-db-1"), newTestClaim("data-db-backup-0"),
+			newTestClaim("data-dbx-0"), newTestClaim("logs"))
+
+		deleted, err := DeleteStatefulSet(client, "ns", "db", c.deleteClaims)
+		if err != nil {
+			t.Fatalf("DeleteStatefulSet() returned error: %s", err)
+		}
+		if !reflect.DeepEqual(deleted, c.deleted) {
+			t.Errorf("DeleteStatefulSet(%t) deleted %v, expected %v", c.deleteClaims, deleted, c.deleted)
+		}
+
+		if _, err := client.AppsV1().StatefulSets("ns").Get(context.TODO(), "db", metaV1.GetOptions{}); err == nil {
+			t.Errorf("expected the stateful set to be deleted")
+		}
+
+		claims, err := client.CoreV1().PersistentVolumeClaims("ns").List(context.TODO(), metaV1.ListOptions{})
+		if err != nil {
+			t.Fatalf("listing claims: %s", err)
+		}
+		remaining := make([]string, 0)
+		for _, claim := range claims.Items {
+			remaining = append(remaining, claim.Name)
+		}
+		sort.Strings(remaining)
+		if !reflect.DeepEqual(remaining, c.remaining) {
+			t.Errorf("DeleteStatefulSet(%t) left claims %v, expected %v", c.deleteClaims, remaining, c.remaining)
+		}
+	}
+}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
@@ -43,6 +44,11 @@ func GetReplicaCounts(cfg *rest.Config, kind, namespace, name string) (*ReplicaC
 		return nil, err
 	}
 
+	return GetReplicaCountsWithGetter(sc, kind, namespace, name)
+}
+
+// GetReplicaCountsWithGetter returns the desired and actual number of replicas using the given scale client.
+func GetReplicaCountsWithGetter(sc scale.ScalesGetter, kind, namespace, name string) (*ReplicaCounts, error) {
 	gr := getGroupResource(kind)
 	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
 	if err != nil {
@@ -64,6 +70,11 @@ func ScaleResource(cfg *rest.Config, kind, namespace, name, count string) (*Repl
 		return nil, err
 	}
 
+	return ScaleResourceWithGetter(sc, kind, namespace, name, count)
+}
+
+// ScaleResourceWithGetter scales the provided resource using the given scale client.
+func ScaleResourceWithGetter(sc scale.ScalesGetter, kind, namespace, name, count string) (*ReplicaCounts, error) {
 	gr := getGroupResource(kind)
 	res, err := sc.Scales(namespace).Get(context.TODO(), gr, name, metaV1.GetOptions{})
 	if err != nil {
@@ -94,6 +105,20 @@ func getScaleGetter(cfg *rest.Config) (scale.ScalesGetter, error) {
 		return nil, err
 	}
 
+	dc := memory.NewMemCacheClient(discoveryClient)
+	drm := restmapper.NewDeferredDiscoveryRESTMapper(dc)
+
+	// Fixes "unable to get full preferred group-version-resource for <resource>: the cache has not been filled yet".
+	// See more: https://github.com/kubernetes/kubernetes/issues/68735
+	drm.Reset()
+
+	return NewScalesGetter(cfg, dc, drm)
+}
+
+// NewScalesGetter creates a scale client which resolves resources through the given discovery client and
+// REST mapper, so that a cluster can share its cached discovery information between requests.
+func NewScalesGetter(cfg *rest.Config, discoveryClient discovery.DiscoveryInterface, mapper meta.RESTMapper) (scale.ScalesGetter, error) {
+	cfg = rest.CopyConfig(cfg)
 	cfg.GroupVersion = &apps.SchemeGroupVersion
 	cfg.NegotiatedSerializer = scheme.Codecs
 
@@ -103,14 +128,7 @@ func getScaleGetter(cfg *rest.Config) (scale.ScalesGetter, error) {
 	}
 
 	resolver := scale.NewDiscoveryScaleKindResolver(discoveryClient)
-	dc := memory.NewMemCacheClient(discoveryClient)
-	drm := restmapper.NewDeferredDiscoveryRESTMapper(dc)
-
-	// Fixes "unable to get full preferred group-version-resource for <resource>: the cache has not been filled yet".
-	// See more: https://github.com/kubernetes/kubernetes/issues/68735
-	drm.Reset()
-
-	return scale.New(restClient, drm, dynamic.LegacyAPIPathResolverFunc, resolver), nil
+	return scale.New(restClient, mapper, dynamic.LegacyAPIPathResolverFunc, resolver), nil
 }
 
 func getGroupResource(kind string) schema.GroupResource {
@@ -120,5 +138,10 @@ func getGroupResource(kind string) schema.GroupResource {
 		return gr
 	}
 
+	// Replication controllers belong to the core API group.
+	if kind == "replicationcontroller" || kind == "replicationcontrollers" {
+		return schema.GroupResource{Resource: kind}
+	}
+
 	return apps.Resource(kind)
 }
@@ -0,0 +1,78 @@
+package cluster
+
+import (
+	"hello-k8s/pkg/model"
+	"hello-k8s/pkg/utils/auth"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Prefix of the kubeconfig encrypted with cluster.encryption_key, rows stored
+// without the prefix hold plain text.
+const sealedPrefix = "aes:"
+
+func init() {
+	model.RegisterModels(&ClusterModel{})
+}
+
+// ClusterModel represents a kubernetes cluster added through the API. The kubeconfig
+// is encrypted with cluster.encryption_key when it is stored.
+type ClusterModel struct {
+	model.BaseModel
+	Name          string  `json:"name" gorm:"column:name;not null;unique_index"`
+	KubeConfig    string  `json:"kubeconfig" gorm:"column:kubeconfig;type:text"`
+	Context       string  `json:"context" gorm:"column:context"`
+	APIServerHost string  `json:"apiserverHost" gorm:"column:apiserverHost"`
+	QPS           float32 `json:"qps" gorm:"column:qps"`
+	Burst         int     `json:"burst" gorm:"column:burst"`
+}
+
+func (c *ClusterModel) TableName() string {
+	return "tb_clusters"
+}
+
+// Create creates a new cluster record, the kubeconfig is encrypted before it is stored.
+func (c *ClusterModel) Create() error {
+	record := *c
+	if record.KubeConfig != "" {
+		sealed, err := auth.Seal(viper.GetString("cluster.encryption_key"), record.KubeConfig)
+		if err != nil {
+			return err
+		}
+		record.KubeConfig = sealedPrefix + sealed
+	}
+
+	if err := model.DB.Self.Create(&record).Error; err != nil {
+		return err
+	}
+
+	c.BaseModel = record.BaseModel
+	return nil
+}
+
+// DeleteCluster deletes the cluster by the cluster name.
+func DeleteCluster(name string) error {
+	return model.DB.Self.Where("name = ?", name).Delete(&ClusterModel{}).Error
+}
+
+// ListCluster lists all clusters with the decrypted kubeconfig.
+func ListCluster() ([]*ClusterModel, error) {
+	clusters := make([]*ClusterModel, 0)
+	if err := model.DB.Self.Order("id asc").Find(&clusters).Error; err != nil {
+		return clusters, err
+	}
+
+	for _, c := range clusters {
+		if !strings.HasPrefix(c.KubeConfig, sealedPrefix) {
+			continue
+		}
+		kubeconfig, err := auth.Open(viper.GetString("cluster.encryption_key"), strings.TrimPrefix(c.KubeConfig, sealedPrefix))
+		if err != nil {
+			return nil, err
+		}
+		c.KubeConfig = kubeconfig
+	}
+
+	return clusters, nil
+}
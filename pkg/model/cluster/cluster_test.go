@@ -0,0 +1,66 @@
+package cluster
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"hello-k8s/pkg/model"
+
+	"github.com/lexkong/log"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	log.InitWithConfig(&log.PassLagerCfg{
+		Writers:     "stdout",
+		LoggerLevel: "ERROR",
+		LoggerFile:  filepath.Join(os.TempDir(), "hello-k8s-test.log"),
+	})
+}
+
+func TestClusterKubeConfigIsEncrypted(t *testing.T) {
+	viper.Set("db.driver", "sqlite")
+	viper.Set("db.name", ":memory:")
+	viper.Set("cluster.encryption_key", "secret")
+	defer viper.Set("cluster.encryption_key", "")
+
+	if err := model.DB.Init(); err != nil {
+		t.Fatalf("Init(): unexpected error: %v", err)
+	}
+	defer model.DB.Close()
+	if err := model.DB.Migrate(); err != nil {
+		t.Fatalf("Migrate(): unexpected error: %v", err)
+	}
+
+	kubeconfig := "apiVersion: v1\nkind: Config\nusers:\n- name: admin\n  user:\n    token: abc\n"
+	c := ClusterModel{Name: "prod", KubeConfig: kubeconfig}
+	if err := c.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+	if c.KubeConfig != kubeconfig {
+		t.Errorf("Create(): modified the kubeconfig of the model")
+	}
+
+	var stored ClusterModel
+	if err := model.DB.Self.Where("name = ?", "prod").First(&stored).Error; err != nil {
+		t.Fatalf("First(): unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(stored.KubeConfig, sealedPrefix) || strings.Contains(stored.KubeConfig, "token") {
+		t.Errorf("Create(): stored kubeconfig is not encrypted: %q", stored.KubeConfig)
+	}
+
+	clusters, err := ListCluster()
+	if err != nil {
+		t.Fatalf("ListCluster(): unexpected error: %v", err)
+	}
+	if len(clusters) != 1 || clusters[0].KubeConfig != kubeconfig {
+		t.Errorf("ListCluster(): expected the decrypted kubeconfig, got %#v", clusters)
+	}
+
+	viper.Set("cluster.encryption_key", "other")
+	if _, err := ListCluster(); err == nil {
+		t.Errorf("ListCluster(): expected an error with a wrong encryption key")
+	}
+}
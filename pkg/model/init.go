@@ -2,13 +2,24 @@ package model
 
 import (
 	"fmt"
+	"net"
+	"sync"
 
 	"github.com/lexkong/log"
 	"github.com/spf13/viper"
 
-	// MySQL driver.
 	"github.com/jinzhu/gorm"
+	// Database drivers.
 	_ "github.com/jinzhu/gorm/dialects/mysql"
+	_ "github.com/jinzhu/gorm/dialects/postgres"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+// Supported database drivers.
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite3"
 )
 
 type Database struct {
@@ -18,63 +29,184 @@ type Database struct {
 
 var DB *Database
 
-func openDB(username, password, addr, name string) *gorm.DB {
-	config := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8&parseTime=%t&loc=%s",
-		username,
-		password,
-		addr,
-		name,
-		true,
-		//"Asia/Shanghai",
-		"Local")
-	db, err := gorm.Open("mysql", config)
+var (
+	modelsLock sync.Mutex
+	// Models registered for automatic schema migration.
+	models []interface{}
+)
+
+// RegisterModels registers the models whose tables are created or updated by Migrate.
+func RegisterModels(values ...interface{}) {
+	modelsLock.Lock()
+	defer modelsLock.Unlock()
+
+	models = append(models, values...)
+}
+
+// driver returns the normalized driver name of the database config prefix,
+// mysql is used when the driver is not set.
+func driver(prefix string) string {
+	switch d := viper.GetString(prefix + ".driver"); d {
+	case "":
+		return DriverMySQL
+	case "sqlite":
+		return DriverSQLite
+	case "postgresql":
+		return DriverPostgres
+	default:
+		return d
+	}
+}
+
+// dsn returns the data source name of the database config prefix.
+func dsn(prefix string) (string, error) {
+	username := viper.GetString(prefix + ".username")
+	password := viper.GetString(prefix + ".password")
+	addr := viper.GetString(prefix + ".addr")
+	name := viper.GetString(prefix + ".name")
+
+	switch driver(prefix) {
+	case DriverMySQL:
+		return fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8&parseTime=%t&loc=%s",
+			username,
+			password,
+			addr,
+			name,
+			true,
+			//"Asia/Shanghai",
+			"Local"), nil
+	case DriverPostgres:
+		host, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return "", err
+		}
+		sslmode := viper.GetString(prefix + ".sslmode")
+		if sslmode == "" {
+			sslmode = "disable"
+		}
+		return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=%s",
+			host, port, username, password, name, sslmode), nil
+	case DriverSQLite:
+		// db.name is the database file path, ":memory:" creates an in-memory database.
+		if name == "" {
+			name = ":memory:"
+		}
+		return name, nil
+	default:
+		return "", fmt.Errorf("unsupported database driver %q", driver(prefix))
+	}
+}
+
+// openDB opens the database configured by the config prefix and checks the
+// connection.
+func openDB(prefix string) (*gorm.DB, error) {
+	source, err := dsn(prefix)
 	if err != nil {
-		log.Errorf(err, "Database connection failed. Database name: %s", name)
+		return nil, err
+	}
+
+	db, err := gorm.Open(driver(prefix), source)
+	if err != nil {
+		log.Errorf(err, "Database connection failed. Database name: %s", viper.GetString(prefix+".name"))
+		return nil, err
 	}
 
 	// set for db connection
-	setupDB(db)
+	setupDB(db, prefix)
+
+	if err := db.DB().Ping(); err != nil {
+		db.Close()
+		return nil, err
+	}
 
-	return db
+	return db, nil
 }
 
-func setupDB(db *gorm.DB) {
+func setupDB(db *gorm.DB, prefix string) {
 	db.LogMode(viper.GetBool("gormlog"))
-	// db.DB().SetMaxOpenConns(20000) // 用于设置最大打开的连接数，默认值为0表示不限制。设置最大的连接数，可以避免并发太高导致连接mysql出现too many connections错误。
-	db.DB().SetMaxIdleConns(0) // 用于设置闲置的连接数。设置闲置的连接数则当开启的一个连接使用完成后可以放在池里等候下一次使用。
+	maxOpenConns := viper.GetInt(prefix + ".max_open_conns")
+	maxIdleConns := viper.GetInt(prefix + ".max_idle_conns")
+	if driver(prefix) == DriverSQLite {
+		// SQLite 只支持一个写连接，并且内存数据库在连接关闭后会被清空，所以固定使用一个长连接.
+		maxOpenConns, maxIdleConns = 1, 1
+	}
+	db.DB().SetMaxOpenConns(maxOpenConns) // 用于设置最大打开的连接数，默认值为0表示不限制。设置最大的连接数，可以避免并发太高导致连接mysql出现too many connections错误。
+	db.DB().SetMaxIdleConns(maxIdleConns) // 用于设置闲置的连接数。设置闲置的连接数则当开启的一个连接使用完成后可以放在池里等候下一次使用。
+	db.DB().SetConnMaxLifetime(viper.GetDuration(prefix + ".conn_max_lifetime"))
 }
 
 // used for cli
-func InitSelfDB() *gorm.DB {
-	return openDB(viper.GetString("db.username"),
-		viper.GetString("db.password"),
-		viper.GetString("db.addr"),
-		viper.GetString("db.name"))
+func InitSelfDB() (*gorm.DB, error) {
+	return openDB("db")
 }
 
-func GetSelfDB() *gorm.DB {
+func GetSelfDB() (*gorm.DB, error) {
 	return InitSelfDB()
 }
 
-func InitDockerDB() *gorm.DB {
-	return openDB(viper.GetString("docker_db.username"),
-		viper.GetString("docker_db.password"),
-		viper.GetString("docker_db.addr"),
-		viper.GetString("docker_db.name"))
+func InitDockerDB() (*gorm.DB, error) {
+	return openDB("docker_db")
 }
 
-func GetDockerDB() *gorm.DB {
+func GetDockerDB() (*gorm.DB, error) {
 	return InitDockerDB()
 }
 
-func (db *Database) Init() {
-	DB = &Database{
-		Self:   GetSelfDB(),
-		Docker: GetDockerDB(),
+// Init opens the databases. The docker database is optional and is only
+// opened when docker_db.name is set.
+func (db *Database) Init() error {
+	self, err := GetSelfDB()
+	if err != nil {
+		return err
 	}
+
+	d := &Database{Self: self}
+	if viper.GetString("docker_db.name") != "" {
+		docker, err := GetDockerDB()
+		if err != nil {
+			self.Close()
+			return err
+		}
+		d.Docker = docker
+	}
+
+	DB = d
+	return nil
+}
+
+// Migrate creates or updates the tables of the registered models.
+func (db *Database) Migrate() error {
+	modelsLock.Lock()
+	defer modelsLock.Unlock()
+
+	return db.Self.AutoMigrate(models...).Error
+}
+
+// Ping checks the connection of the databases.
+func (db *Database) Ping() error {
+	if !db.Ready() {
+		return fmt.Errorf("database is not initialized")
+	}
+
+	if err := db.Self.DB().Ping(); err != nil {
+		return err
+	}
+
+	if db.Docker != nil {
+		return db.Docker.DB().Ping()
+	}
+
+	return nil
+}
+
+// Ready reports whether the database has been initialized.
+func (db *Database) Ready() bool {
+	return db != nil && db.Self != nil
 }
 
 func (db *Database) Close() {
 	DB.Self.Close()
-	DB.Docker.Close()
+	if DB.Docker != nil {
+		DB.Docker.Close()
+	}
 }
@@ -6,7 +6,9 @@ import (
 
 	deploy "hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 type BaseModel struct {
@@ -96,3 +98,166 @@ type JobArgs struct {
 	// PodTemplate 定义了 Job 对象管理的 Pod 对象的定义参数.
 	PodTemplate PodArgs `json:"podTemplate"`
 }
+
+// DeploymentArgs 定义了构建一个 Deployment 对象时所需参数.
+type DeploymentArgs struct {
+	// Number of desired pods. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// The deployment strategy to use to replace existing pods with new ones.
+	// +optional
+	Strategy DeploymentStrategyArgs `json:"strategy"`
+
+	// Label selector for pods. The selector labels are added to the pod labels,
+	// defaults to the pod labels when it is empty.
+	// +optional
+	Selector []deploy.Label `json:"selector"`
+
+	// Port mappings for the service that is created together with the deployment.
+	// The service is created if there is at least one port mapping.
+	// +optional
+	PortMappings []deploy.PortMapping `json:"portMappings"`
+
+	// Whether the created service is external.
+	// +optional
+	IsExternal bool `json:"isExternal"`
+
+	// PodTemplate 定义了 Deployment 对象管理的 Pod 对象的定义参数.
+	PodTemplate PodArgs `json:"podTemplate"`
+}
+
+// StatefulSetArgs 定义了构建一个 StatefulSet 对象时所需参数.
+type StatefulSetArgs struct {
+	// Number of desired pods. Defaults to 1.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Whether the pods are created and deleted one by one (OrderedReady) or all at once
+	// (Parallel). Default is OrderedReady.
+	// +optional
+	PodManagementPolicy appsv1.PodManagementPolicyType `json:"podManagementPolicy"`
+
+	// Label selector for pods. The selector labels are added to the pod labels,
+	// defaults to the pod labels when it is empty.
+	// +optional
+	Selector []deploy.Label `json:"selector"`
+
+	// Ports of the headless service that is created together with the stateful set.
+	// +optional
+	PortMappings []deploy.PortMapping `json:"portMappings"`
+
+	// VolumeClaimTemplates 定义了为每个 Pod 创建的 PersistentVolumeClaim 对象.
+	// +optional
+	VolumeClaimTemplates []VolumeClaimTemplateArgs `json:"volumeClaimTemplates"`
+
+	// PodTemplate 定义了 StatefulSet 对象管理的 Pod 对象的定义参数.
+	PodTemplate PodArgs `json:"podTemplate"`
+}
+
+// DaemonSetArgs 定义了构建一个 DaemonSet 对象时所需参数.
+type DaemonSetArgs struct {
+	// Label selector for pods. The selector labels are added to the pod labels,
+	// defaults to the pod labels when it is empty.
+	// +optional
+	Selector []deploy.Label `json:"selector"`
+
+	// Labels of the nodes the pods are scheduled on, all nodes when it is empty.
+	// +optional
+	NodeSelector []deploy.Label `json:"nodeSelector"`
+
+	// Tolerations of the pods, e.g. to run on the master nodes.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations"`
+
+	// The daemon set strategy to use to replace existing pods with new ones.
+	// +optional
+	Strategy DaemonSetStrategyArgs `json:"strategy"`
+
+	// PodTemplate 定义了 DaemonSet 对象管理的 Pod 对象的定义参数.
+	PodTemplate PodArgs `json:"podTemplate"`
+}
+
+// DaemonSetStrategyArgs 定义了 DaemonSet 对象的更新策略.
+type DaemonSetStrategyArgs struct {
+	// Type of daemon set update. Can be "RollingUpdate" or "OnDelete". Default is RollingUpdate.
+	// +optional
+	Type appsv1.DaemonSetUpdateStrategyType `json:"type"`
+
+	// The maximum number of pods that can be unavailable during the rolling update,
+	// can be an absolute number (ex: 5) or a percentage of scheduled pods (ex: 10%).
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" swaggertype:"string"`
+}
+
+// ServiceArgs 定义了构建一个 Service 对象时所需参数.
+type ServiceArgs struct {
+	// Type of the service, one of ClusterIP, NodePort and LoadBalancer. Defaults to ClusterIP.
+	// +optional
+	Type corev1.ServiceType `json:"type"`
+
+	// Whether the service is headless, i.e. has no cluster IP. Only valid for ClusterIP services.
+	// +optional
+	Headless bool `json:"headless"`
+
+	// Labels of the pods the traffic is routed to, must match at least one pod.
+	Selector []deploy.Label `json:"selector"`
+
+	// Ports 定义了 Service 对象的端口映射.
+	Ports []ServicePortArgs `json:"ports"`
+}
+
+// ServicePortArgs 定义了 Service 对象的一个端口.
+type ServicePortArgs struct {
+	deploy.PortMapping
+
+	// Port on each node for NodePort and LoadBalancer services, allocated by the apiserver
+	// when it is zero.
+	// +optional
+	NodePort int32 `json:"nodePort"`
+}
+
+// PersistentVolumeClaimArgs 定义了构建一个 PersistentVolumeClaim 对象时所需参数.
+type PersistentVolumeClaimArgs struct {
+	// StoraegClassName 存储类名称.
+	StorageClassName *string `json:"storageClassName"`
+
+	// StorageCapacity 申请存储容量.
+	StorageCapacity float64 `json:"storageCapacity"`
+
+	// AccessModes 存储的访问模式.
+	AccessModes []string `json:"AccessModes"`
+}
+
+// VolumeClaimTemplateArgs 定义了 StatefulSet 对象的 PersistentVolumeClaim 模板，创建的
+// PersistentVolumeClaim 对象名称为 <name>-<StatefulSet 对象名称>-<序号>.
+type VolumeClaimTemplateArgs struct {
+	// Name 模板名称，同时也是容器中存储卷的名称.
+	Name string `json:"name"`
+
+	PersistentVolumeClaimArgs
+
+	// MountPath 持久化存储挂载路径.
+	MountPath string `json:"mountPath"`
+
+	// ReadOnly
+	ReadOnly bool `json:"readOnly"`
+}
+
+// DeploymentStrategyArgs 定义了 Deployment 对象的更新策略.
+type DeploymentStrategyArgs struct {
+	// Type of deployment. Can be "Recreate" or "RollingUpdate". Default is RollingUpdate.
+	// +optional
+	Type appsv1.DeploymentStrategyType `json:"type"`
+
+	// The maximum number of pods that can be scheduled above the desired number of
+	// pods during the rolling update, can be an absolute number (ex: 5) or a
+	// percentage of desired pods (ex: 10%).
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" swaggertype:"string"`
+
+	// The maximum number of pods that can be unavailable during the rolling update,
+	// can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty" swaggertype:"string"`
+}
@@ -0,0 +1,59 @@
+package user
+
+import "hello-k8s/pkg/model"
+
+// UserNamespaceModel represents a namespace owned by an user.
+type UserNamespaceModel struct {
+	model.BaseModel
+	Username  string `json:"username" gorm:"column:username;not null;unique_index:idx_user_namespace"`
+	Cluster   string `json:"cluster" gorm:"column:cluster;not null;unique_index:idx_user_namespace"`
+	Namespace string `json:"namespace" gorm:"column:namespace;not null;unique_index:idx_user_namespace"`
+}
+
+func (n *UserNamespaceModel) TableName() string {
+	return "tb_user_namespaces"
+}
+
+// Create grants the namespace to the user.
+func (n *UserNamespaceModel) Create() error {
+	return model.DB.Self.Create(&n).Error
+}
+
+// DeleteUserNamespace revokes the namespace from the user.
+func DeleteUserNamespace(username, cluster, namespace string) error {
+	return model.DB.Self.Unscoped().
+		Where("username = ? AND cluster = ? AND namespace = ?", username, cluster, namespace).
+		Delete(&UserNamespaceModel{}).Error
+}
+
+// DeleteAllUserNamespace revokes all namespaces from the user.
+func DeleteAllUserNamespace(username string) error {
+	return model.DB.Self.Unscoped().Where("username = ?", username).Delete(&UserNamespaceModel{}).Error
+}
+
+// DeleteNamespaceOwners revokes the namespace of the cluster from all users.
+func DeleteNamespaceOwners(cluster, namespace string) error {
+	return model.DB.Self.Unscoped().
+		Where("cluster = ? AND namespace = ?", cluster, namespace).
+		Delete(&UserNamespaceModel{}).Error
+}
+
+// ListUserNamespace lists all namespaces owned by the user.
+func ListUserNamespace(username string) ([]*UserNamespaceModel, error) {
+	namespaces := make([]*UserNamespaceModel, 0)
+	if err := model.DB.Self.Where("username = ?", username).Order("id asc").Find(&namespaces).Error; err != nil {
+		return namespaces, err
+	}
+
+	return namespaces, nil
+}
+
+// OwnNamespace returns true if the namespace of the cluster is owned by the user.
+func OwnNamespace(username, cluster, namespace string) (bool, error) {
+	var count uint64
+	err := model.DB.Self.Model(&UserNamespaceModel{}).
+		Where("username = ? AND cluster = ? AND namespace = ?", username, cluster, namespace).
+		Count(&count).Error
+
+	return count > 0, err
+}
@@ -1,7 +1,6 @@
 package user
 
 import (
-	"fmt"
 	"hello-k8s/pkg/model"
 	"hello-k8s/pkg/utils/auth"
 	"hello-k8s/pkg/utils/constvar"
@@ -9,11 +8,16 @@ import (
 	validator "gopkg.in/go-playground/validator.v9"
 )
 
+func init() {
+	model.RegisterModels(&UserModel{}, &UserNamespaceModel{})
+}
+
 // User represents a registered user.
 type UserModel struct {
 	model.BaseModel
-	Username string `json:"username" gorm:"column:username;not null" binding:"required" validate:"min=1,max=32"`
+	Username string `json:"username" gorm:"column:username;not null;unique_index" binding:"required" validate:"min=1,max=32"`
 	Password string `json:"password" gorm:"column:password;not null" binding:"required" validate:"min=5,max=128"`
+	Admin    bool   `json:"admin" gorm:"column:admin;not null;default:false"`
 }
 
 func (c *UserModel) TableName() string {
@@ -25,11 +29,12 @@ func (u *UserModel) Create() error {
 	return model.DB.Self.Create(&u).Error
 }
 
-// DeleteUser deletes the user by the user identifier.
+// DeleteUser deletes the user by the user identifier. The row is removed so
+// that the username can be used again.
 func DeleteUser(id uint64) error {
 	user := UserModel{}
 	user.BaseModel.ID = id
-	return model.DB.Self.Delete(&user).Error
+	return model.DB.Self.Unscoped().Delete(&user).Error
 }
 
 // Update updates an user account information.
@@ -44,6 +49,57 @@ func GetUser(username string) (*UserModel, error) {
 	return u, d.Error
 }
 
+// UserExists returns true if the username has been taken.
+func UserExists(username string) (bool, error) {
+	var count uint64
+	err := model.DB.Self.Model(&UserModel{}).Where("username = ?", username).Count(&count).Error
+	return count > 0, err
+}
+
+// IsAdmin returns true if the user is marked as an administrator in the
+// database. Administrators can access all namespaces and manage users,
+// clusters and namespace owners.
+func IsAdmin(username string) bool {
+	var count uint64
+	err := model.DB.Self.Model(&UserModel{}).
+		Where("username = ? AND admin = ?", username, true).
+		Count(&count).Error
+
+	return err == nil && count > 0
+}
+
+// BootstrapAdmin makes sure there is an administrator. When there is none, the
+// user is created as an administrator with the password, or an existing user
+// is promoted and its password is reset. Returns true if the user has been
+// bootstrapped.
+func BootstrapAdmin(username, password string) (bool, error) {
+	var count uint64
+	if err := model.DB.Self.Model(&UserModel{}).Where("admin = ?", true).Count(&count).Error; err != nil {
+		return false, err
+	}
+	if count > 0 {
+		return false, nil
+	}
+
+	u := &UserModel{}
+	d := model.DB.Self.Where("username = ?", username).First(u)
+	if d.Error != nil && !d.RecordNotFound() {
+		return false, d.Error
+	}
+
+	u.Username = username
+	u.Password = password
+	u.Admin = true
+	if err := u.Validate(); err != nil {
+		return false, err
+	}
+	if err := u.Encrypt(); err != nil {
+		return false, err
+	}
+
+	return true, model.DB.Self.Save(u).Error
+}
+
 // ListUser List all users
 func ListUser(username string, offset, limit int) ([]*UserModel, uint64, error) {
 	if limit == 0 {
@@ -53,12 +109,12 @@ func ListUser(username string, offset, limit int) ([]*UserModel, uint64, error)
 	users := make([]*UserModel, 0)
 	var count uint64
 
-	where := fmt.Sprintf("username like '%%%s%%'", username)
-	if err := model.DB.Self.Model(&UserModel{}).Where(where).Count(&count).Error; err != nil {
+	like := "%" + username + "%"
+	if err := model.DB.Self.Model(&UserModel{}).Where("username LIKE ?", like).Count(&count).Error; err != nil {
 		return users, count, err
 	}
 
-	if err := model.DB.Self.Where(where).Offset(offset).Limit(limit).Order("id desc").Find(&users).Error; err != nil {
+	if err := model.DB.Self.Where("username LIKE ?", like).Offset(offset).Limit(limit).Order("id desc").Find(&users).Error; err != nil {
 		return users, count, err
 	}
 
@@ -0,0 +1,195 @@
+package user
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"hello-k8s/pkg/model"
+
+	"github.com/lexkong/log"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	log.InitWithConfig(&log.PassLagerCfg{
+		Writers:     "stdout",
+		LoggerLevel: "ERROR",
+		LoggerFile:  filepath.Join(os.TempDir(), "hello-k8s-test.log"),
+	})
+}
+
+func setupDB(t *testing.T) {
+	viper.Set("db.driver", "sqlite")
+	viper.Set("db.name", ":memory:")
+
+	if err := model.DB.Init(); err != nil {
+		t.Fatalf("Init(): unexpected error: %v", err)
+	}
+	if err := model.DB.Migrate(); err != nil {
+		t.Fatalf("Migrate(): unexpected error: %v", err)
+	}
+}
+
+func TestUserModel(t *testing.T) {
+	setupDB(t)
+	defer model.DB.Close()
+
+	for _, name := range []string{"alice", "bob", "albert"} {
+		u := UserModel{Username: name, Password: "password"}
+		if err := u.Encrypt(); err != nil {
+			t.Fatalf("Encrypt(): unexpected error: %v", err)
+		}
+		if err := u.Create(); err != nil {
+			t.Fatalf("Create(): unexpected error: %v", err)
+		}
+	}
+
+	u, err := GetUser("alice")
+	if err != nil {
+		t.Fatalf("GetUser(): unexpected error: %v", err)
+	}
+	if err := u.Compare("password"); err != nil {
+		t.Errorf("Compare(): expected password to match, got %v", err)
+	}
+	if err := u.Compare("wrong"); err == nil {
+		t.Errorf("Compare(): expected wrong password not to match")
+	}
+
+	cases := []struct {
+		username string
+		expected uint64
+	}{
+		{"", 3},
+		{"al", 2},
+		{"bob", 1},
+		{"' OR '1'='1", 0},
+	}
+	for _, c := range cases {
+		users, count, err := ListUser(c.username, 0, 0)
+		if err != nil {
+			t.Fatalf("ListUser(%q): unexpected error: %v", c.username, err)
+		}
+		if count != c.expected || uint64(len(users)) != c.expected {
+			t.Errorf("ListUser(%q): expected %d users, got count %d and %d users", c.username, c.expected, count, len(users))
+		}
+	}
+
+	if err := DeleteUser(u.ID); err != nil {
+		t.Fatalf("DeleteUser(): unexpected error: %v", err)
+	}
+	if _, err := GetUser("alice"); err == nil {
+		t.Errorf("GetUser(): expected deleted user not to be found")
+	}
+
+	// The username of a deleted user can be used again.
+	u = &UserModel{Username: "alice", Password: "password"}
+	if err := u.Create(); err != nil {
+		t.Fatalf("Create(): expected deleted username to be created again, got %v", err)
+	}
+}
+
+func TestUserExists(t *testing.T) {
+	setupDB(t)
+	defer model.DB.Close()
+
+	u := UserModel{Username: "alice", Password: "password"}
+	if err := u.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+
+	if ok, err := UserExists("alice"); err != nil || !ok {
+		t.Errorf("UserExists(): expected alice to exist, got %v, %v", ok, err)
+	}
+	if ok, err := UserExists("bob"); err != nil || ok {
+		t.Errorf("UserExists(): expected bob not to exist, got %v, %v", ok, err)
+	}
+
+	// The username is unique.
+	u = UserModel{Username: "alice", Password: "password"}
+	if err := u.Create(); err == nil {
+		t.Errorf("Create(): expected duplicated username to fail")
+	}
+}
+
+func TestBootstrapAdmin(t *testing.T) {
+	setupDB(t)
+	defer model.DB.Close()
+
+	u := UserModel{Username: "alice", Password: "password"}
+	if err := u.Encrypt(); err != nil {
+		t.Fatalf("Encrypt(): unexpected error: %v", err)
+	}
+	if err := u.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+	if IsAdmin("alice") {
+		t.Errorf("IsAdmin(): expected alice not to be an administrator")
+	}
+
+	// The existing user is promoted and its password is reset.
+	if ok, err := BootstrapAdmin("alice", "secret"); err != nil || !ok {
+		t.Fatalf("BootstrapAdmin(): expected alice to be bootstrapped, got %v, %v", ok, err)
+	}
+	if !IsAdmin("alice") {
+		t.Errorf("IsAdmin(): expected alice to be an administrator")
+	}
+	admin, err := GetUser("alice")
+	if err != nil {
+		t.Fatalf("GetUser(): unexpected error: %v", err)
+	}
+	if err := admin.Compare("secret"); err != nil {
+		t.Errorf("Compare(): expected the password to be reset, got %v", err)
+	}
+
+	// Nothing is changed once there is an administrator.
+	if ok, err := BootstrapAdmin("bob", "secret"); err != nil || ok {
+		t.Errorf("BootstrapAdmin(): expected bob not to be bootstrapped, got %v, %v", ok, err)
+	}
+	if ok, _ := UserExists("bob"); ok || IsAdmin("bob") {
+		t.Errorf("BootstrapAdmin(): expected bob not to be created")
+	}
+}
+
+func TestUserNamespaceModel(t *testing.T) {
+	setupDB(t)
+	defer model.DB.Close()
+
+	n := UserNamespaceModel{Username: "alice", Cluster: "default", Namespace: "dev"}
+	if err := n.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+
+	if ok, err := OwnNamespace("alice", "default", "dev"); err != nil || !ok {
+		t.Errorf("OwnNamespace(): expected alice to own dev, got %v, %v", ok, err)
+	}
+	if ok, err := OwnNamespace("alice", "other", "dev"); err != nil || ok {
+		t.Errorf("OwnNamespace(): expected alice not to own dev of other cluster, got %v, %v", ok, err)
+	}
+
+	if err := DeleteUserNamespace("alice", "default", "dev"); err != nil {
+		t.Fatalf("DeleteUserNamespace(): unexpected error: %v", err)
+	}
+	if ok, _ := OwnNamespace("alice", "default", "dev"); ok {
+		t.Errorf("OwnNamespace(): expected namespace to be revoked")
+	}
+
+	// The namespace can be granted again after it has been revoked.
+	n = UserNamespaceModel{Username: "alice", Cluster: "default", Namespace: "dev"}
+	if err := n.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+
+	n = UserNamespaceModel{Username: "bob", Cluster: "default", Namespace: "dev"}
+	if err := n.Create(); err != nil {
+		t.Fatalf("Create(): unexpected error: %v", err)
+	}
+	if err := DeleteNamespaceOwners("default", "dev"); err != nil {
+		t.Fatalf("DeleteNamespaceOwners(): unexpected error: %v", err)
+	}
+	for _, username := range []string{"alice", "bob"} {
+		if ok, _ := OwnNamespace(username, "default", "dev"); ok {
+			t.Errorf("OwnNamespace(): expected dev to be revoked from %s", username)
+		}
+	}
+}
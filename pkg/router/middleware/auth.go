@@ -0,0 +1,139 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/model/user"
+	"hello-k8s/pkg/utils/errno"
+	"hello-k8s/pkg/utils/token"
+	"hello-k8s/pkg/utils/tool"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+)
+
+// AuthMiddleware is a middleware function that rejects the requests without
+// a valid token or whose user has been deleted, and injects the token context
+// into the request context.
+func AuthMiddleware(c *gin.Context) {
+	ctx, err := token.ParseRequest(c)
+	if err != nil {
+		sendAbort(c, errno.ErrTokenInvalid)
+		return
+	}
+
+	exists, err := user.UserExists(ctx.Username)
+	if err != nil {
+		log.Errorf(err, "Failed to check user %q", ctx.Username)
+		sendAbort(c, errno.ErrDatabase)
+		return
+	}
+	if !exists {
+		sendAbort(c, errno.ErrTokenInvalid)
+		return
+	}
+
+	c.Set(token.ContextKey, ctx)
+	c.Next()
+}
+
+// AdminMiddleware is a middleware function that only allows the
+// administrators to continue. It must be used after AuthMiddleware.
+func AdminMiddleware(c *gin.Context) {
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		sendAbort(c, errno.ErrTokenInvalid)
+		return
+	}
+
+	if !user.IsAdmin(ctx.Username) {
+		sendAbort(c, errno.ErrPermissionDenied)
+		return
+	}
+
+	c.Next()
+}
+
+// NamespaceAccess is a middleware function that makes sure the namespace in
+// the `namespace` path parameter or in the `namespace` field of the request
+// body is owned by the current user. Administrators can access all
+// namespaces. Requests of other users without a namespace are denied, so the
+// cluster scoped routes must not use this middleware. It must be used after
+// AuthMiddleware and KubernetesClient.
+func NamespaceAccess(c *gin.Context) {
+	ctx, err := token.FromContext(c)
+	if err != nil {
+		sendAbort(c, errno.ErrTokenInvalid)
+		return
+	}
+
+	if user.IsAdmin(ctx.Username) {
+		c.Next()
+		return
+	}
+
+	namespace, err := requestNamespace(c)
+	if err != nil {
+		sendAbort(c, errno.ErrBind)
+		return
+	}
+
+	if namespace == "" {
+		sendAbort(c, errno.ErrPermissionDenied)
+		return
+	}
+
+	ok, err := user.OwnNamespace(ctx.Username, c.GetString(client.ClusterContextKey), namespace)
+	if err != nil {
+		log.Errorf(err, "Failed to check the owner of namespace %q", namespace)
+		sendAbort(c, errno.ErrDatabase)
+		return
+	}
+	if !ok {
+		sendAbort(c, errno.ErrPermissionDenied)
+		return
+	}
+
+	c.Next()
+}
+
+// Returns the namespace of the request. The body of the requests other than
+// GET and HEAD is always parsed as JSON whatever the Content-Type is, and it
+// is restored after it has been read so that the handlers can bind it again.
+func requestNamespace(c *gin.Context) (string, error) {
+	if namespace := c.Param("namespace"); namespace != "" {
+		return namespace, nil
+	}
+
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead || c.Request.Body == nil {
+		return "", nil
+	}
+
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		return "", err
+	}
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	if len(body) == 0 {
+		return "", nil
+	}
+
+	var r struct {
+		Namespace string `json:"namespace"`
+	}
+	if err := json.Unmarshal(body, &r); err != nil {
+		return "", err
+	}
+
+	return r.Namespace, nil
+}
+
+// Sends the error response and stops the pending handlers.
+func sendAbort(c *gin.Context, err error) {
+	tool.SendResponse(c, err, nil)
+	c.Abort()
+}
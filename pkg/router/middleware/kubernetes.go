@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"hello-k8s/pkg/kubernetes/client"
+	"hello-k8s/pkg/utils/errno"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ClusterHeader is the request header used to select the kubernetes cluster.
+const ClusterHeader = "X-Cluster-Name"
+
+// ClusterRegistry is a middleware function that injects the kubernetes
+// cluster registry into the request context.
+func ClusterRegistry(r *client.Registry) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(client.RegistryContextKey, r)
+		c.Next()
+	}
+}
+
+// KubernetesClient is a middleware function that injects the kubernetes
+// client of the cluster selected by the `X-Cluster-Name` header or the
+// `cluster` query parameter into the request context. The default cluster
+// is used when neither of them is set. Only GET and HEAD requests read from the
+// informer cache, other requests get the live client so that read-modify-write
+// updates start from the latest resource version and created objects can be read
+// back immediately.
+func KubernetesClient(c *gin.Context) {
+	registry, err := client.RegistryFromContext(c)
+	if err != nil {
+		sendAbort(c, errno.ErrCreateK8sClientSet)
+		return
+	}
+
+	name := c.GetHeader(ClusterHeader)
+	if name == "" {
+		name = c.Query("cluster")
+	}
+	if name == "" {
+		name = registry.Default()
+	}
+
+	m, err := registry.Get(name)
+	if err != nil {
+		sendAbort(c, errno.ErrClusterNotFound)
+		return
+	}
+
+	c.Set(client.ClusterContextKey, name)
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		c.Set(client.ContextKey, m.Client())
+	} else {
+		c.Set(client.ContextKey, m.LiveClient())
+	}
+	c.Set(client.ConfigContextKey, m.Config())
+	c.Set(client.ManagerContextKey, m)
+	c.Next()
+}
@@ -2,16 +2,29 @@ package router
 
 import (
 	_ "hello-k8s/docs"
+	"hello-k8s/pkg/api/v1/cluster"
+	"hello-k8s/pkg/api/v1/resources/apply"
 	"hello-k8s/pkg/api/v1/resources/configmap"
 	"hello-k8s/pkg/api/v1/resources/container"
 	"hello-k8s/pkg/api/v1/resources/cronjob"
+	"hello-k8s/pkg/api/v1/resources/daemonset"
 	"hello-k8s/pkg/api/v1/resources/deployment"
+	"hello-k8s/pkg/api/v1/resources/event"
+	"hello-k8s/pkg/api/v1/resources/export"
+	"hello-k8s/pkg/api/v1/resources/horizontalpodautoscaler"
+	"hello-k8s/pkg/api/v1/resources/ingress"
 	"hello-k8s/pkg/api/v1/resources/job"
+	"hello-k8s/pkg/api/v1/resources/namespace"
+	"hello-k8s/pkg/api/v1/resources/node"
 	"hello-k8s/pkg/api/v1/resources/persistentvolumeclaim"
 	"hello-k8s/pkg/api/v1/resources/pod"
+	"hello-k8s/pkg/api/v1/resources/raw"
+	"hello-k8s/pkg/api/v1/resources/scale"
 	"hello-k8s/pkg/api/v1/resources/secret"
 	"hello-k8s/pkg/api/v1/resources/service"
+	"hello-k8s/pkg/api/v1/resources/statefulset"
 	"hello-k8s/pkg/api/v1/resources/storageclass"
+	"hello-k8s/pkg/api/v1/resources/terminal"
 	"hello-k8s/pkg/api/v1/sd"
 	"hello-k8s/pkg/api/v1/user"
 	"hello-k8s/pkg/router/middleware"
@@ -46,13 +59,67 @@ func Load(g *gin.Engine, mw ...gin.HandlerFunc) *gin.Engine {
 	// swagger api docs
 	g.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
+	// api for authentication functionalities
+	g.POST("/v1/login", user.Login)
+
+	g.GET("/v1/me", middleware.AuthMiddleware, user.Current)
+
 	u := g.Group("/v1/user")
 	{
-		u.POST("", user.Create)
+		u.POST("", middleware.AuthMiddleware, middleware.AdminMiddleware, user.Create)
+		u.GET("", middleware.AuthMiddleware, middleware.AdminMiddleware, user.List)
+		u.GET("/:username", middleware.AuthMiddleware, user.Get)
+		u.PUT("/:username", middleware.AuthMiddleware, user.Update)
+		u.DELETE("/:username", middleware.AuthMiddleware, middleware.AdminMiddleware, user.Delete)
+	}
+
+	// 只有管理员可以管理用户的命名空间
+	un := g.Group("/v1/user/:username/namespace")
+	un.Use(middleware.AuthMiddleware, middleware.AdminMiddleware)
+	{
+		un.GET("", user.ListNamespace)
+		un.POST("", user.AddNamespace)
+		un.DELETE("", user.RemoveNamespace)
+	}
+
+	// 终端会话由 /resource/container/shell 创建，只有创建者可以连接
+	g.GET("/v1/terminal/:id", middleware.AuthMiddleware, terminal.Attach)
+
+	cl := g.Group("/v1/cluster")
+	cl.Use(middleware.AuthMiddleware, middleware.AdminMiddleware)
+	{
+		cl.GET("", cluster.List)
+		cl.POST("", cluster.Create)
+		cl.GET("/:name/test", cluster.Test)
+		cl.DELETE("/:name", cluster.Delete)
+	}
+
+	// 集群级别的接口没有命名空间，只能由管理员访问，或者由接口自己检查用户的命名空间权限
+	rc := g.Group("/resource")
+	rc.Use(middleware.AuthMiddleware, middleware.KubernetesClient)
+	{
+		rc.POST("/apply", apply.Apply)
+
+		rc.POST("/namespace/create", middleware.AdminMiddleware, namespace.Create)
+		rc.DELETE("/namespace/delete", middleware.AdminMiddleware, namespace.Delete)
+		rc.GET("/namespace/list", namespace.GetNamespaceList)
+
+		rc.GET("/node/list", middleware.AdminMiddleware, node.GetNodeList)
+		rc.GET("/node/detail/:name", middleware.AdminMiddleware, node.GetNode)
+		rc.GET("/node/pods/:name", middleware.AdminMiddleware, node.GetNodePods)
+		rc.PUT("/node/cordon/:name", middleware.AdminMiddleware, node.Cordon)
+		rc.PUT("/node/uncordon/:name", middleware.AdminMiddleware, node.Uncordon)
+		rc.PUT("/node/drain/:name", middleware.AdminMiddleware, node.Drain)
+
+		rc.GET("/storageclass/detail/:name", middleware.AdminMiddleware, storageclass.GetStorageClass)
+		rc.GET("/storageclass/list", middleware.AdminMiddleware, storageclass.GetStorageClassList)
 	}
 
 	r := g.Group("/resource")
+	r.Use(middleware.AuthMiddleware, middleware.KubernetesClient, middleware.NamespaceAccess)
 	{
+		r.GET("/namespace/detail/:namespace", namespace.GetNamespace)
+
 		r.POST("/persistentvolumeclaim/create", persistentvolumeclaim.Create)
 		r.DELETE("/persistentvolumeclaim/delete", persistentvolumeclaim.Delete)
 		r.GET("/persistentvolumeclaim/detail/:name/:namespace", persistentvolumeclaim.GetPersistentVolumeClaim)
@@ -69,18 +136,51 @@ func Load(g *gin.Engine, mw ...gin.HandlerFunc) *gin.Engine {
 		r.GET("/cronjob/detail/:name/:namespace", cronjob.GetCronJob)
 		r.GET("/cronjob/list/:namespace", cronjob.GetCronJobList)
 
+		r.POST("/deployment/create", deployment.Create)
 		r.DELETE("/deployment/delete", deployment.Delete)
 		r.GET("/deployment/detail/:name/:namespace", deployment.GetDeployment)
 		r.GET("/deployment/list/:namespace", deployment.GetDeploymentList)
 		r.GET("/deployment/pods/:name/:namespace", deployment.GetDeploymentPods)
-
+		r.PUT("/deployment/image", deployment.UpdateImage)
+		r.GET("/deployment/rollout/status/:name/:namespace", deployment.GetRolloutStatus)
+		r.GET("/deployment/rollout/history/:name/:namespace", deployment.GetRolloutHistory)
+		r.PUT("/deployment/rollout/undo", deployment.Rollback)
+
+		r.POST("/statefulset/create", statefulset.Create)
+		r.DELETE("/statefulset/delete", statefulset.Delete)
+		r.GET("/statefulset/detail/:name/:namespace", statefulset.GetStatefulSet)
+		r.GET("/statefulset/list/:namespace", statefulset.GetStatefulSetList)
+		r.GET("/statefulset/pods/:name/:namespace", statefulset.GetStatefulSetPods)
+		r.GET("/statefulset/events/:name/:namespace", statefulset.GetStatefulSetEvents)
+		r.PUT("/statefulset/update", statefulset.Update)
+
+		r.POST("/daemonset/create", daemonset.Create)
+		r.DELETE("/daemonset/delete", daemonset.Delete)
+		r.GET("/daemonset/detail/:name/:namespace", daemonset.GetDaemonSet)
+		r.GET("/daemonset/list/:namespace", daemonset.GetDaemonSetList)
+		r.GET("/daemonset/pods/:name/:namespace", daemonset.GetDaemonSetPods)
+		r.GET("/daemonset/events/:name/:namespace", daemonset.GetDaemonSetEvents)
+		r.PUT("/daemonset/update", daemonset.Update)
+
+		r.POST("/ingress/create", ingress.Create)
+		r.DELETE("/ingress/delete", ingress.Delete)
+		r.GET("/ingress/detail/:name/:namespace", ingress.GetIngress)
+		r.GET("/ingress/list/:namespace", ingress.GetIngressList)
+		r.GET("/ingress/routes/:namespace", ingress.GetRoutes)
+		r.PUT("/ingress/update", ingress.Update)
+
+		r.POST("/horizontalpodautoscaler/create", horizontalpodautoscaler.Create)
+		r.DELETE("/horizontalpodautoscaler/delete", horizontalpodautoscaler.Delete)
+		r.GET("/horizontalpodautoscaler/detail/:name/:namespace", horizontalpodautoscaler.GetHorizontalPodAutoscaler)
+		r.GET("/horizontalpodautoscaler/list/:namespace", horizontalpodautoscaler.GetHorizontalPodAutoscalerList)
+		r.PUT("/horizontalpodautoscaler/update", horizontalpodautoscaler.Update)
+
+		r.POST("/service/create", service.Create)
 		r.DELETE("/service/delete", service.Delete)
 		r.GET("/service/detail/:name/:namespace", service.GetService)
 		r.GET("/service/list/:namespace", service.GetServiceList)
 		r.GET("/service/pods/:name/:namespace", service.GetServicePods)
-
-		r.GET("/storageclass/detail/:name", storageclass.GetStorageClass)
-		r.GET("/storageclass/list", storageclass.GetStorageClassList)
+		r.PUT("/service/update", service.Update)
 
 		r.POST("/secret/create", secret.Create)
 		r.DELETE("/secret/delete", secret.Delete)
@@ -92,10 +192,27 @@ func Load(g *gin.Engine, mw ...gin.HandlerFunc) *gin.Engine {
 		r.GET("/configmap/list/:namespace", configmap.GetConfigMapList)
 		r.DELETE("/configmap/delete", configmap.Delete)
 
+		r.GET("/scale/:kind/:name/:namespace", scale.GetReplicaCounts)
+		r.PUT("/scale/:kind/:name/:namespace", scale.ScaleResource)
+
 		r.GET("/pod/detail/:name/:namespace", pod.GetPod)
 		r.GET("/pod/list/:namespace", pod.GetPodList)
 		r.GET("/pod/container/:podId/:namespace", container.GetPodContainers)
 		r.GET("/container/logs/:namespace/:podId/:container", container.GetLogs)
+		r.GET("/container/download/:namespace/:podId/:container", container.DownloadLogs)
+		r.GET("/logs/:kind/:name/:namespace", container.GetAggregatedLogs)
+		r.GET("/container/shell/:namespace/:podId/:container", terminal.CreateSession)
+
+		r.GET("/event/list/:namespace", event.GetNamespaceEvents)
+		r.GET("/event/object/:kind/:name/:namespace", event.GetObjectEvents)
+		r.GET("/event/watch/:namespace", event.WatchEvents)
+
+		r.GET("/raw/:kind/:namespace/:name", raw.GetObject)
+		r.PUT("/raw/:kind/:namespace/:name", raw.UpdateObject)
+		r.DELETE("/raw/:kind/:namespace/:name", raw.DeleteObject)
+
+		r.GET("/export/object/:kind/:namespace/:name", export.ExportObject)
+		r.GET("/export/namespace/:namespace", export.ExportNamespace)
 	}
 
 	// The health check handlers
@@ -105,6 +222,8 @@ func Load(g *gin.Engine, mw ...gin.HandlerFunc) *gin.Engine {
 		svcd.GET("/disk", sd.DiskCheck)
 		svcd.GET("/cpu", sd.CPUCheck)
 		svcd.GET("/ram", sd.RAMCheck)
+		svcd.GET("/cache", sd.CacheCheck)
+		svcd.GET("/db", sd.DBCheck)
 	}
 
 	return g
@@ -15,6 +15,9 @@ var (
 	ErrUserNotFound      = &Errno{Code: 100102, Message: "The user was not found."}
 	ErrTokenInvalid      = &Errno{Code: 100103, Message: "Token 无效！"}
 	ErrPasswordIncorrect = &Errno{Code: 100104, Message: "用户密码无效！"}
+	ErrPermissionDenied  = &Errno{Code: 100105, Message: "没有访问该资源的权限！"}
+	ErrUserExists        = &Errno{Code: 100106, Message: "The user already exists."}
+	ErrLoginFailed       = &Errno{Code: 100107, Message: "用户名或密码错误！"}
 
 	ErrBadK8sConfig         = &Errno{Code: 200001, Message: "Kubernetes config err."}
 	ErrCreateK8sClientSet   = &Errno{Code: 200002, Message: "Kubernete clientset init err."}
@@ -24,6 +27,13 @@ var (
 	ErrCreateMySQLClientSet = &Errno{Code: 200006, Message: "创建MySQL Clientset 对象失败！"}
 	ErrUpGraderRequest      = &Errno{Code: 200020, Message: "升级get请求为websocket协议失败."}
 
+	// Kubernetes cluster.
+	ErrClusterNotFound = &Errno{Code: 200011, Message: "Kubernetes cluster not found."}
+	ErrClusterExists   = &Errno{Code: 200012, Message: "Kubernetes cluster already exists."}
+	ErrAddCluster      = &Errno{Code: 200013, Message: "Add kubernetes cluster failed."}
+	ErrRemoveCluster   = &Errno{Code: 200014, Message: "Remove kubernetes cluster failed."}
+	ErrConnectCluster  = &Errno{Code: 200015, Message: "Connect to kubernetes cluster failed."}
+
 	ErrCreateServiceAccount     = &Errno{Code: 200102, Message: "Create serviceaccount failed."}
 	ErrCreateClusterRole        = &Errno{Code: 200103, Message: "Create clustrrole failed."}
 	ErrCreateClusterRoleBinding = &Errno{Code: 200104, Message: "Crate clusterrolebinding failed."}
@@ -68,10 +78,12 @@ var (
 	ErrGetDeploymentList     = &Errno{Code: 200424, Message: "Get deployment list failed."}
 	ErrGetDeploymentPodsList = &Errno{Code: 200425, Message: "Get deployment pods list failed."}
 
+	ErrCreateService      = &Errno{Code: 200431, Message: "Create service failed."}
 	ErrDeleteService      = &Errno{Code: 200432, Message: "Delete service failed."}
 	ErrGetService         = &Errno{Code: 200433, Message: "Get service failed."}
 	ErrGetServiceList     = &Errno{Code: 200434, Message: "Get service list failed."}
 	ErrGetServicePodsList = &Errno{Code: 200435, Message: "Get service pods list failed."}
+	ErrUpdateService      = &Errno{Code: 200436, Message: "Update service failed."}
 
 	ErrGetStorageClass     = &Errno{Code: 200443, Message: "Get storageclass failed."}
 	ErrGetStorageClassList = &Errno{Code: 200444, Message: "Get storageclass list failed."}
@@ -95,6 +107,56 @@ var (
 	ErrGetPodList       = &Errno{Code: 200483, Message: "Get pod list failed."}
 	ErrGetPodContainers = &Errno{Code: 200484, Message: "Get pod containers failed."}
 	ErrGetPodLogs       = &Errno{Code: 200485, Message: "Get pod logs failed."}
+	ErrCreateTerminal   = &Errno{Code: 200486, Message: "Create terminal session failed."}
+	ErrAttachTerminal   = &Errno{Code: 200487, Message: "Attach terminal session failed."}
+
+	ErrCreateNamespace   = &Errno{Code: 200491, Message: "Create namespace failed."}
+	ErrGetNamespace      = &Errno{Code: 200492, Message: "Get namespace failed."}
+	ErrGetNamespaceList  = &Errno{Code: 200493, Message: "Get namespace list failed."}
+	ErrDeleteNamespace   = &Errno{Code: 200494, Message: "Delete namespace failed."}
+	ErrNamespacePreset   = &Errno{Code: 200495, Message: "Namespace quota or limit range preset not found."}
+	ErrNamespaceNotFound = &Errno{Code: 200496, Message: "Namespace not found."}
+
+	ErrGetNodeList   = &Errno{Code: 200501, Message: "Get node list failed."}
+	ErrGetNodeDetail = &Errno{Code: 200502, Message: "Get node detail failed."}
+	ErrGetNodePods   = &Errno{Code: 200503, Message: "Get node pods failed."}
+	ErrCordonNode    = &Errno{Code: 200504, Message: "Cordon node failed."}
+	ErrDrainNode     = &Errno{Code: 200505, Message: "Drain node failed."}
+
+	ErrGetEvents = &Errno{Code: 200511, Message: "Get events failed."}
+
+	ErrGetRawResource    = &Errno{Code: 200521, Message: "Get resource failed."}
+	ErrUpdateRawResource = &Errno{Code: 200522, Message: "Update resource failed."}
+	ErrDeleteRawResource = &Errno{Code: 200523, Message: "Delete resource failed."}
+
+	ErrExportResource = &Errno{Code: 200531, Message: "Export resource failed."}
+
+	ErrCreateStatefulSet  = &Errno{Code: 200541, Message: "Create stateful set failed."}
+	ErrDeleteStatefulSet  = &Errno{Code: 200542, Message: "Delete stateful set failed."}
+	ErrGetStatefulSet     = &Errno{Code: 200543, Message: "Get stateful set failed."}
+	ErrGetStatefulSetList = &Errno{Code: 200544, Message: "Get stateful set list failed."}
+	ErrGetStatefulSetPods = &Errno{Code: 200545, Message: "Get stateful set pods list failed."}
+	ErrUpdateStatefulSet  = &Errno{Code: 200546, Message: "Update stateful set failed."}
+
+	ErrCreateDaemonSet  = &Errno{Code: 200551, Message: "Create daemon set failed."}
+	ErrDeleteDaemonSet  = &Errno{Code: 200552, Message: "Delete daemon set failed."}
+	ErrGetDaemonSet     = &Errno{Code: 200553, Message: "Get daemon set failed."}
+	ErrGetDaemonSetList = &Errno{Code: 200554, Message: "Get daemon set list failed."}
+	ErrGetDaemonSetPods = &Errno{Code: 200555, Message: "Get daemon set pods list failed."}
+	ErrUpdateDaemonSet  = &Errno{Code: 200556, Message: "Update daemon set failed."}
+
+	ErrCreateIngress    = &Errno{Code: 200561, Message: "Create ingress failed."}
+	ErrDeleteIngress    = &Errno{Code: 200562, Message: "Delete ingress failed."}
+	ErrGetIngress       = &Errno{Code: 200563, Message: "Get ingress failed."}
+	ErrGetIngressList   = &Errno{Code: 200564, Message: "Get ingress list failed."}
+	ErrUpdateIngress    = &Errno{Code: 200565, Message: "Update ingress failed."}
+	ErrGetIngressRoutes = &Errno{Code: 200566, Message: "Get ingress routes failed."}
+
+	ErrCreateHorizontalPodAutoscaler  = &Errno{Code: 200571, Message: "Create horizontal pod autoscaler failed."}
+	ErrDeleteHorizontalPodAutoscaler  = &Errno{Code: 200572, Message: "Delete horizontal pod autoscaler failed."}
+	ErrGetHorizontalPodAutoscaler     = &Errno{Code: 200573, Message: "Get horizontal pod autoscaler failed."}
+	ErrGetHorizontalPodAutoscalerList = &Errno{Code: 200574, Message: "Get horizontal pod autoscaler list failed."}
+	ErrUpdateHorizontalPodAutoscaler  = &Errno{Code: 200575, Message: "Update horizontal pod autoscaler failed."}
 
 	ErrCreateCloneCodeJob = &Errno{Code: 201010, Message: "Create clone code job failed."}
 
@@ -103,4 +165,10 @@ var (
 	ErrDeployAtomService     = &Errno{Code: 201030, Message: "Create atom service failed."}
 	ErrScaleDeployment       = &Errno{Code: 201031, Message: "Scale deployment pods count failed."}
 	ErrUpdateDeploymentImage = &Errno{Code: 201032, Message: "Update deployment image failed."}
+	ErrGetReplicaCounts      = &Errno{Code: 201033, Message: "Get replica counts failed."}
+	ErrGetRolloutStatus      = &Errno{Code: 201034, Message: "Get deployment rollout status failed."}
+	ErrGetRolloutHistory     = &Errno{Code: 201035, Message: "Get deployment rollout history failed."}
+	ErrRollbackDeployment    = &Errno{Code: 201036, Message: "Rollback deployment failed."}
+	ErrInvalidManifest       = &Errno{Code: 201037, Message: "Invalid manifest."}
+	ErrApplyManifest         = &Errno{Code: 201038, Message: "Apply manifest failed."}
 )
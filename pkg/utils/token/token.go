@@ -0,0 +1,136 @@
+package token
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	authApi "hello-k8s/pkg/kubernetes/kuberesource/auth/api"
+	"hello-k8s/pkg/kubernetes/kuberesource/auth/jwe"
+
+	"github.com/gin-gonic/gin"
+	"github.com/lexkong/log"
+	"github.com/spf13/viper"
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ContextKey 是当前登录用户在 gin.Context 中的键名.
+const ContextKey = "TokenContext"
+
+var (
+	// ErrMissingHeader means the `Authorization` header was empty.
+	ErrMissingHeader = errors.New("The length of the `Authorization` header is zero.")
+	// ErrNotInitialized means Init has not been called.
+	ErrNotInitialized = errors.New("The token manager is not initialized.")
+
+	manager authApi.TokenManager
+)
+
+// Context is the context of the token.
+type Context struct {
+	Username string
+}
+
+// Init 使用 token.private_key 和 token.public_key 配置的 RSA 密钥初始化 token 管理器,
+// token 的有效期由 token.ttl 配置，单位为秒. 未配置密钥时自动生成，服务重启后已签发的
+// token 全部失效.
+func Init() error {
+	holder, err := keyHolder(viper.GetString("token.private_key"), viper.GetString("token.public_key"))
+	if err != nil {
+		return err
+	}
+
+	m := jwe.NewJWETokenManager(holder)
+	if viper.IsSet("token.ttl") {
+		m.SetTokenTTL(time.Duration(viper.GetInt("token.ttl")))
+	}
+
+	manager = m
+	return nil
+}
+
+func keyHolder(privateKeyPath, publicKeyPath string) (jwe.KeyHolder, error) {
+	if privateKeyPath == "" || publicKeyPath == "" {
+		log.Warn("token.private_key or token.public_key is not set, tokens will be invalidated on restart.")
+		return jwe.NewStaticRSAKeyHolder(nil), nil
+	}
+
+	priv, err := ioutil.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, err := ioutil.ReadFile(publicKeyPath)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := jwe.ParseRSAKey(string(priv), string(pub))
+	if err != nil {
+		return nil, err
+	}
+
+	return jwe.NewStaticRSAKeyHolder(key), nil
+}
+
+// Sign signs the context with the encryption key.
+func Sign(c Context) (string, error) {
+	if manager == nil {
+		return "", ErrNotInitialized
+	}
+
+	return manager.Generate(api.AuthInfo{Username: c.Username})
+}
+
+// Parse validates the token and returns the context if the token is valid.
+func Parse(tokenString string) (*Context, error) {
+	if manager == nil {
+		return nil, ErrNotInitialized
+	}
+
+	authInfo, err := manager.Decrypt(tokenString)
+	if err != nil {
+		return nil, err
+	}
+
+	if authInfo.Username == "" {
+		return nil, errors.New("The token does not contain a username.")
+	}
+
+	return &Context{Username: authInfo.Username}, nil
+}
+
+// ParseRequest gets the token from the `Authorization` header, or from the
+// `token` query parameter for websocket requests, and parses it.
+func ParseRequest(c *gin.Context) (*Context, error) {
+	var t string
+	header := c.Request.Header.Get("Authorization")
+	if len(header) != 0 {
+		// Parse the header to get the token part.
+		fmt.Sscanf(header, "Bearer %s", &t)
+	} else {
+		t = c.Query("token")
+	}
+
+	if len(t) == 0 {
+		return nil, ErrMissingHeader
+	}
+
+	return Parse(t)
+}
+
+// FromContext 返回由中间件注入到 gin.Context 中的当前登录用户.
+func FromContext(c *gin.Context) (*Context, error) {
+	v, ok := c.Get(ContextKey)
+	if !ok {
+		return nil, errors.New("token context is not set in the request context")
+	}
+
+	ctx, ok := v.(*Context)
+	if !ok {
+		return nil, errors.New("invalid token context in the request context")
+	}
+
+	return ctx, nil
+}
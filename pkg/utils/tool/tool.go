@@ -2,12 +2,15 @@ package tool
 
 import (
 	"context"
+	"fmt"
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
 	deploy "hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
 	"hello-k8s/pkg/model"
 	"hello-k8s/pkg/utils/errno"
 	"net/http"
 	"path"
 	"strconv"
+	"strings"
 	"unsafe"
 
 	"github.com/gin-gonic/gin"
@@ -25,6 +28,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes"
 )
 
@@ -45,16 +49,34 @@ func SendResponse(c *gin.Context, err error, data interface{}) {
 	})
 }
 
-func CreateNamespace(namespace string, clientset kubernetes.Interface) {
+// NamespaceExists returns true if the namespace exists.
+func NamespaceExists(namespace string, clientset kubernetes.Interface) (bool, error) {
 	_, err := clientset.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
 	if errors.IsNotFound(err) {
-		ns := &corev1.Namespace{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: namespace,
-			},
-		}
-		clientset.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
+		return false, nil
 	}
+
+	return err == nil, err
+}
+
+// CreateNamespace creates the namespace if it does not exist.
+func CreateNamespace(namespace string, clientset kubernetes.Interface) error {
+	_, err := clientset.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+		},
+	}
+	_, err = clientset.CoreV1().Namespaces().Create(context.TODO(), ns, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		return nil
+	}
+
+	return err
 }
 
 func NewServiceAccount(serviceAccountName string) *corev1.ServiceAccount {
@@ -194,6 +216,82 @@ func CreatePodSpec(name string, podSpecArgs model.PodArgs) *corev1.PodSpec {
 	return &podSpec
 }
 
+// CreatePersistentVolumeClaimSpec builds the spec of a persistent volume claim.
+func CreatePersistentVolumeClaimSpec(args model.PersistentVolumeClaimArgs) corev1.PersistentVolumeClaimSpec {
+	spec := corev1.PersistentVolumeClaimSpec{}
+
+	if args.StorageClassName != nil {
+		spec.StorageClassName = args.StorageClassName
+	}
+
+	if args.StorageCapacity > 0 {
+		in := strconv.FormatFloat(args.StorageCapacity, 'f', 5, 32)
+		capacity := in + viper.GetString("constants.storage_unit")
+		log.Debugf("capacity is %s", capacity)
+		request, _ := resource.ParseQuantity(capacity)
+		spec.Resources = corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceStorage: request,
+			},
+		}
+	}
+
+	if len(args.AccessModes) > 0 {
+		for _, accessMode := range args.AccessModes {
+			var mode corev1.PersistentVolumeAccessMode
+			switch accessMode {
+			case "ReadWriteOnce":
+				mode = corev1.ReadWriteOnce
+			case "ReadOnlyMany":
+				mode = corev1.ReadOnlyMany
+			case "ReadWriteMany":
+				mode = corev1.ReadWriteMany
+			}
+			spec.AccessModes = append(spec.AccessModes, mode)
+		}
+	}
+
+	return spec
+}
+
+// CreateServicePorts builds the ports of a service, the protocol defaults to TCP and the target
+// port defaults to the port.
+func CreateServicePorts(args []model.ServicePortArgs) []corev1.ServicePort {
+	ports := make([]corev1.ServicePort, 0, len(args))
+	for _, arg := range args {
+		protocol := arg.Protocol
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+
+		targetPort := arg.TargetPort
+		if targetPort == 0 {
+			targetPort = arg.Port
+		}
+
+		ports = append(ports, corev1.ServicePort{
+			Name:       fmt.Sprintf("%s-%d-%d", strings.ToLower(string(protocol)), arg.Port, targetPort),
+			Protocol:   protocol,
+			Port:       arg.Port,
+			TargetPort: intstr.FromInt(int(targetPort)),
+			NodePort:   arg.NodePort,
+		})
+	}
+
+	return ports
+}
+
+// CreateServicePortsFromMappings builds the ports of a service from the port mappings of a
+// workload, see CreateServicePorts.
+func CreateServicePortsFromMappings(mappings []deploy.PortMapping) []corev1.ServicePort {
+	args := make([]model.ServicePortArgs, 0, len(mappings))
+	for _, mapping := range mappings {
+		args = append(args, model.ServicePortArgs{PortMapping: mapping})
+	}
+
+	return CreateServicePorts(args)
+}
+
 func ConvertEnvVarsSpec(variables []deploy.EnvironmentVariable) []corev1.EnvVar {
 	var result []corev1.EnvVar
 	for _, variable := range variables {
@@ -213,6 +311,26 @@ func GetLabelsMap(labels []deploy.Label) map[string]string {
 	return result
 }
 
+// GetWorkloadLabels returns the selector labels of a workload and the labels of its pods. The
+// pod labels are used as the selector when no selector is specified, app=<name> when there are
+// no pod labels either. Pods must carry the selector labels, so they are added to the pod labels.
+func GetWorkloadLabels(name string, selector, podLabels []deploy.Label) (map[string]string, map[string]string) {
+	selectorLabels := GetLabelsMap(selector)
+	if len(selectorLabels) == 0 {
+		selectorLabels = GetLabelsMap(podLabels)
+	}
+	if len(selectorLabels) == 0 {
+		selectorLabels["app"] = name
+	}
+
+	labels := GetLabelsMap(podLabels)
+	for k, v := range selectorLabels {
+		labels[k] = v
+	}
+
+	return selectorLabels, labels
+}
+
 // String Convert []byte object to string.
 func String(b []byte) string {
 	return *(*string)(unsafe.Pointer(&b))
@@ -306,6 +424,32 @@ func GetPage(c *gin.Context) int {
 	return result
 }
 
+// ParseDataSelectQuery 解析请求中的 itemsPerPage、page、sortBy 和 filterBy 查询参数，
+// 返回对应的 DataSelectQuery 对象. 例如:
+// ?itemsPerPage=10&page=1&sortBy=d,creationTimestamp&filterBy=name,nginx
+// 缺少或无效的 itemsPerPage、page 参数不分页，无效的 sortBy、filterBy 参数不排序、不过滤.
+func ParseDataSelectQuery(c *gin.Context) *dataselect.DataSelectQuery {
+	paginationQuery := dataselect.NoPagination
+	itemsPerPage, errItems := strconv.Atoi(c.Query("itemsPerPage"))
+	page, errPage := strconv.Atoi(c.Query("page"))
+	if errItems == nil && errPage == nil && itemsPerPage > 0 && page > 0 {
+		// Frontend pages start from 1 and backend starts from 0
+		paginationQuery = dataselect.NewPaginationQuery(itemsPerPage, page-1)
+	}
+
+	sortQuery := dataselect.NoSort
+	if sortBy := c.Query("sortBy"); sortBy != "" {
+		sortQuery = dataselect.NewSortQuery(strings.Split(sortBy, ","))
+	}
+
+	filterQuery := dataselect.NoFilter
+	if filterBy := c.Query("filterBy"); filterBy != "" {
+		filterQuery = dataselect.NewFilterQuery(strings.Split(filterBy, ","))
+	}
+
+	return dataselect.NewDataSelectQuery(paginationQuery, sortQuery, filterQuery, dataselect.NoMetrics)
+}
+
 func GenShortId() (string, error) {
 	return shortid.Generate()
 }
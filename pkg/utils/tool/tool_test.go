@@ -0,0 +1,154 @@
+package tool
+
+import (
+	"context"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"hello-k8s/pkg/kubernetes/kuberesource/resource/dataselect"
+	deploy "hello-k8s/pkg/kubernetes/kuberesource/resource/deployment"
+
+	"github.com/gin-gonic/gin"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestParseDataSelectQuery(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	cases := []struct {
+		query      string
+		pagination *dataselect.PaginationQuery
+		sort       *dataselect.SortQuery
+		filter     *dataselect.FilterQuery
+	}{
+		{"", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=10&page=1", dataselect.NewPaginationQuery(10, 0), dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=5&page=3", dataselect.NewPaginationQuery(5, 2), dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=10", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?page=2", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=ten&page=1", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=10&page=0", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?itemsPerPage=-1&page=1", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{
+			"?sortBy=d,creationTimestamp,a,name",
+			dataselect.NoPagination,
+			&dataselect.SortQuery{SortByList: []dataselect.SortBy{
+				{Property: dataselect.CreationTimestampProperty, Ascending: false},
+				{Property: dataselect.NameProperty, Ascending: true},
+			}},
+			dataselect.NoFilter,
+		},
+		{"?sortBy=d", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{"?sortBy=x,name", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{
+			"?filterBy=name,nginx",
+			dataselect.NoPagination,
+			dataselect.NoSort,
+			&dataselect.FilterQuery{FilterByList: []dataselect.FilterBy{
+				{Property: dataselect.NameProperty, Value: dataselect.StdComparableString("nginx")},
+			}},
+		},
+		{"?filterBy=name", dataselect.NoPagination, dataselect.NoSort, dataselect.NoFilter},
+		{
+			"?itemsPerPage=20&page=2&sortBy=a,name&filterBy=namespace,default",
+			dataselect.NewPaginationQuery(20, 1),
+			&dataselect.SortQuery{SortByList: []dataselect.SortBy{{Property: dataselect.NameProperty, Ascending: true}}},
+			&dataselect.FilterQuery{FilterByList: []dataselect.FilterBy{
+				{Property: dataselect.NamespaceProperty, Value: dataselect.StdComparableString("default")},
+			}},
+		},
+	}
+
+	for _, c := range cases {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest("GET", "/resource/pod/list/default"+c.query, nil)
+
+		actual := ParseDataSelectQuery(ctx)
+		if !reflect.DeepEqual(actual.PaginationQuery, c.pagination) {
+			t.Errorf("ParseDataSelectQuery(%q) pagination == %#v, expected %#v", c.query, actual.PaginationQuery, c.pagination)
+		}
+		if !reflect.DeepEqual(actual.SortQuery, c.sort) {
+			t.Errorf("ParseDataSelectQuery(%q) sort == %#v, expected %#v", c.query, actual.SortQuery, c.sort)
+		}
+		if !reflect.DeepEqual(actual.FilterQuery, c.filter) {
+			t.Errorf("ParseDataSelectQuery(%q) filter == %#v, expected %#v", c.query, actual.FilterQuery, c.filter)
+		}
+	}
+}
+
+func TestNamespaceExists(t *testing.T) {
+	clientset := fake.NewSimpleClientset(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dev"}})
+
+	cases := map[string]bool{
+		"dev":     true,
+		"missing": false,
+	}
+	for namespace, expected := range cases {
+		actual, err := NamespaceExists(namespace, clientset)
+		if err != nil {
+			t.Fatalf("NamespaceExists(%q): unexpected error: %v", namespace, err)
+		}
+		if actual != expected {
+			t.Errorf("NamespaceExists(%q) == %v, expected %v", namespace, actual, expected)
+		}
+	}
+
+	// Namespaces are never created implicitly.
+	if _, err := clientset.CoreV1().Namespaces().Get(context.TODO(), "missing", metav1.GetOptions{}); err == nil {
+		t.Errorf("NamespaceExists(): expected namespace missing not to be created")
+	}
+}
+
+func TestCreateServicePortsFromMappings(t *testing.T) {
+	mappings := []deploy.PortMapping{
+		{Port: 80},
+		{Port: 443, TargetPort: 8443, Protocol: corev1.ProtocolTCP},
+		{Port: 53, TargetPort: 5353, Protocol: corev1.ProtocolUDP},
+	}
+	expected := []corev1.ServicePort{
+		{Name: "tcp-80-80", Protocol: corev1.ProtocolTCP, Port: 80, TargetPort: intstr.FromInt(80)},
+		{Name: "tcp-443-8443", Protocol: corev1.ProtocolTCP, Port: 443, TargetPort: intstr.FromInt(8443)},
+		{Name: "udp-53-5353", Protocol: corev1.ProtocolUDP, Port: 53, TargetPort: intstr.FromInt(5353)},
+	}
+
+	actual := CreateServicePortsFromMappings(mappings)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("CreateServicePortsFromMappings() == %#v, expected %#v", actual, expected)
+	}
+}
+
+func TestGetWorkloadLabels(t *testing.T) {
+	cases := []struct {
+		selector, podLabels []deploy.Label
+		expectedSelector    map[string]string
+		expectedLabels      map[string]string
+	}{
+		{nil, nil, map[string]string{"app": "web"}, map[string]string{"app": "web"}},
+		{
+			nil,
+			[]deploy.Label{{Key: "app", Value: "nginx"}, {Key: "tier", Value: "front"}},
+			map[string]string{"app": "nginx", "tier": "front"},
+			map[string]string{"app": "nginx", "tier": "front"},
+		},
+		{
+			[]deploy.Label{{Key: "app", Value: "nginx"}},
+			[]deploy.Label{{Key: "app", Value: "other"}, {Key: "tier", Value: "front"}},
+			map[string]string{"app": "nginx"},
+			map[string]string{"app": "nginx", "tier": "front"},
+		},
+	}
+
+	for _, c := range cases {
+		selector, labels := GetWorkloadLabels("web", c.selector, c.podLabels)
+		if !reflect.DeepEqual(selector, c.expectedSelector) {
+			t.Errorf("GetWorkloadLabels(%v, %v) selector == %v, expected %v", c.selector, c.podLabels, selector, c.expectedSelector)
+		}
+		if !reflect.DeepEqual(labels, c.expectedLabels) {
+			t.Errorf("GetWorkloadLabels(%v, %v) labels == %v, expected %v", c.selector, c.podLabels, labels, c.expectedLabels)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Package wsutil contains the helpers shared by the WebSocket handlers.
+package wsutil
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// WriteWait is the time allowed to write a message to the peer.
+	WriteWait = 10 * time.Second
+	// PongWait is the time allowed to read the next pong message from the peer.
+	PongWait = 60 * time.Second
+	// PingPeriod is the period of the pings sent to the peer. Must be less than PongWait.
+	PingPeriod = PongWait * 9 / 10
+
+	// Maximum length of the close reason, a control frame payload is limited to 125 bytes.
+	maxCloseReasonBytes = 123
+)
+
+// Upgrader upgrades the get requests to the WebSocket protocol.
+var Upgrader = websocket.Upgrader{
+	CheckOrigin: func(r *http.Request) bool {
+		return true
+	},
+}
+
+// ReadPeer reads and discards the messages of the peer so that control messages
+// are processed, the returned channel is closed when the peer goes away.
+func ReadPeer(ws *websocket.Conn) <-chan struct{} {
+	closed := make(chan struct{})
+
+	ws.SetReadLimit(512)
+	ws.SetReadDeadline(time.Now().Add(PongWait))
+	ws.SetPongHandler(func(string) error {
+		return ws.SetReadDeadline(time.Now().Add(PongWait))
+	})
+
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	return closed
+}
+
+// Ping sends a ping message to the peer.
+func Ping(ws *websocket.Conn) error {
+	return ws.WriteControl(websocket.PingMessage, nil, time.Now().Add(WriteWait))
+}
+
+// Close sends a close message with the reason to the peer, the reason is
+// truncated if it does not fit in a control frame.
+func Close(ws *websocket.Conn, code int, text string) {
+	if len(text) > maxCloseReasonBytes {
+		text = text[:maxCloseReasonBytes]
+	}
+	message := websocket.FormatCloseMessage(code, text)
+	ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(WriteWait))
+}